@@ -0,0 +1,136 @@
+package backup
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+
+	"github.com/pkg/errors"
+	"github.com/tmc/figma"
+)
+
+// Archive is a read-only view of an archive written by Team.
+type Archive struct {
+	fsys     fs.FS
+	closer   io.Closer
+	manifest *Manifest
+}
+
+// Open opens the directory or zip archive at name.
+func Open(name string) (*Archive, error) {
+	a := &Archive{}
+	if isZip(name) {
+		r, err := zip.OpenReader(name)
+		if err != nil {
+			return nil, err
+		}
+		a.fsys, a.closer = r, r
+	} else {
+		a.fsys = os.DirFS(name)
+	}
+	a.manifest = &Manifest{}
+	if err := a.readJSON(ManifestName, a.manifest); err != nil {
+		a.Close()
+		return nil, errors.Wrap(err, "reading manifest")
+	}
+	return a, nil
+}
+
+// Close releases any resources held by the archive.
+func (a *Archive) Close() error {
+	if a.closer == nil {
+		return nil
+	}
+	return a.closer.Close()
+}
+
+// Manifest returns the manifest of the archive.
+func (a *Archive) Manifest() *Manifest {
+	return a.manifest
+}
+
+// FileJSON returns the stored JSON for a file key.
+func (a *Archive) FileJSON(key string) ([]byte, error) {
+	fe, err := a.entry(key)
+	if err != nil {
+		return nil, err
+	}
+	return fs.ReadFile(a.fsys, path.Join(fe.Path, "file.json"))
+}
+
+// File decodes the stored file for a file key.
+func (a *Archive) File(key string) (*figma.File, error) {
+	buf, err := a.FileJSON(key)
+	if err != nil {
+		return nil, err
+	}
+	result := &figma.File{}
+	return result, json.Unmarshal(buf, result)
+}
+
+// Files decodes every file in the archive, keyed by file key.
+func (a *Archive) Files() (map[string]*figma.File, error) {
+	result := make(map[string]*figma.File)
+	for _, p := range a.manifest.Projects {
+		for _, fe := range p.Files {
+			f, err := a.File(fe.Key)
+			if err != nil {
+				return nil, errors.Wrapf(err, "reading file %v", fe.Key)
+			}
+			result[fe.Key] = f
+		}
+	}
+	return result, nil
+}
+
+// Versions returns the stored versions for a file key.
+func (a *Archive) Versions(key string) ([]figma.Version, error) {
+	fe, err := a.entry(key)
+	if err != nil {
+		return nil, err
+	}
+	var result []figma.Version
+	return result, a.readJSON(path.Join(fe.Path, "versions.json"), &result)
+}
+
+// Comments returns the stored comments for a file key.
+func (a *Archive) Comments(key string) ([]figma.Comment, error) {
+	fe, err := a.entry(key)
+	if err != nil {
+		return nil, err
+	}
+	var result []figma.Comment
+	return result, a.readJSON(path.Join(fe.Path, "comments.json"), &result)
+}
+
+// Image returns the stored bitmap for an image reference within a file.
+func (a *Archive) Image(key, ref string) ([]byte, error) {
+	fe, err := a.entry(key)
+	if err != nil {
+		return nil, err
+	}
+	name, ok := fe.Images[ref]
+	if !ok {
+		return nil, errors.Errorf("backup: no image %v in file %v", ref, key)
+	}
+	return fs.ReadFile(a.fsys, name)
+}
+
+func (a *Archive) entry(key string) (*FileEntry, error) {
+	fe, ok := a.manifest.File(key)
+	if !ok {
+		return nil, errors.Errorf("backup: no file %v in archive", key)
+	}
+	return fe, nil
+}
+
+func (a *Archive) readJSON(name string, v interface{}) error {
+	buf, err := fs.ReadFile(a.fsys, name)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(buf, v)
+}
@@ -0,0 +1,251 @@
+package backup
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"path"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tmc/figma"
+)
+
+// ManifestName is the name of the manifest at the root of every archive.
+const ManifestName = "manifest.json"
+
+// Manifest describes the contents of an archive.
+type Manifest struct {
+	// The team that was archived.
+	TeamID string `json:"team_id"`
+	// The time the archive was first written.
+	CreatedAt time.Time `json:"created_at"`
+	// The time the archive was last refreshed.
+	UpdatedAt time.Time `json:"updated_at"`
+	// The projects of the team, along with their files.
+	Projects []ProjectEntry `json:"projects"`
+}
+
+// ProjectEntry is a project stored in an archive.
+type ProjectEntry struct {
+	figma.Project
+	Files []FileEntry `json:"files"`
+}
+
+// FileEntry is a file stored in an archive.
+type FileEntry struct {
+	figma.FileMeta
+	// Directory within the archive holding the file's data.
+	Path string `json:"path"`
+	// The time the file's data was last fetched.
+	FetchedAt time.Time `json:"fetched_at"`
+	// Number of versions stored.
+	Versions int `json:"versions"`
+	// Number of comments stored.
+	Comments int `json:"comments"`
+	// Image fill bitmaps stored, keyed by image reference. Values are paths within the archive.
+	Images map[string]string `json:"images,omitempty"`
+}
+
+// File looks up the entry for a file key.
+func (m *Manifest) File(key string) (*FileEntry, bool) {
+	if m == nil {
+		return nil, false
+	}
+	for i := range m.Projects {
+		for j := range m.Projects[i].Files {
+			if m.Projects[i].Files[j].Key == key {
+				return &m.Projects[i].Files[j], true
+			}
+		}
+	}
+	return nil, false
+}
+
+// Options allows configuration of a backup.
+type Options struct {
+	// HTTPClient is used to download image fills. Defaults to http.DefaultClient.
+	HTTPClient *http.Client
+	// SkipImages disables downloading of image fills.
+	SkipImages bool
+	// Force refreshes every file regardless of its modification time.
+	Force bool
+}
+
+// Team archives the projects, files, versions, comments and image fills of a team to dst.
+// If dst ends in ".zip" a zip archive is written, otherwise dst is treated as a directory.
+// If dst already holds an archive, only files whose LastModified time changed are fetched again,
+// and files and images no longer in the team are removed from it.
+func Team(c *figma.Client, teamID, dst string, opts *Options) (*Manifest, error) {
+	if opts == nil {
+		opts = &Options{}
+	}
+	b := &backuper{client: c, opts: opts, httpClient: opts.HTTPClient}
+	if b.httpClient == nil {
+		b.httpClient = http.DefaultClient
+	}
+
+	var prev *Manifest
+	if a, err := Open(dst); err == nil {
+		prev = a.Manifest()
+		a.Close()
+	}
+	s, err := newSink(dst)
+	if err != nil {
+		return nil, err
+	}
+	m, err := b.team(s, teamID, prev)
+	if err != nil {
+		s.abort()
+		return nil, err
+	}
+	return m, s.close()
+}
+
+type backuper struct {
+	client     *figma.Client
+	httpClient *http.Client
+	opts       *Options
+}
+
+func (b *backuper) team(s sink, teamID string, prev *Manifest) (*Manifest, error) {
+	now := time.Now().UTC()
+	m := &Manifest{
+		TeamID:    teamID,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	if prev != nil && prev.TeamID == teamID {
+		m.CreatedAt = prev.CreatedAt
+	} else {
+		prev = nil
+	}
+
+	projects, err := b.client.GetProjectsForTeam(teamID)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting projects")
+	}
+	for _, p := range projects {
+		files, err := b.client.GetFilesForProject(p.ID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting files for project %v", p.ID)
+		}
+		pe := ProjectEntry{Project: p}
+		for _, f := range files {
+			if old, ok := prev.File(f.Key); ok && !b.opts.Force && old.LastModified == f.LastModified {
+				if err := s.keep(old.Path); err != nil {
+					return nil, errors.Wrapf(err, "keeping file %v", f.Key)
+				}
+				fe := *old
+				fe.FileMeta = f
+				pe.Files = append(pe.Files, fe)
+				continue
+			}
+			fe, err := b.file(s, f, now)
+			if err != nil {
+				return nil, errors.Wrapf(err, "archiving file %v", f.Key)
+			}
+			pe.Files = append(pe.Files, *fe)
+		}
+		m.Projects = append(m.Projects, pe)
+	}
+
+	buf, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return m, s.write(ManifestName, buf)
+}
+
+func (b *backuper) file(s sink, f figma.FileMeta, now time.Time) (*FileEntry, error) {
+	fe := &FileEntry{
+		FileMeta:  f,
+		Path:      path.Join("files", f.Key),
+		FetchedAt: now,
+	}
+	buf, err := b.client.GetFileJSON(f.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting file")
+	}
+	if err := s.write(path.Join(fe.Path, "file.json"), buf); err != nil {
+		return nil, err
+	}
+
+	versions, err := b.client.GetFileVersions(f.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting versions")
+	}
+	fe.Versions = len(versions)
+	if err := writeJSON(s, path.Join(fe.Path, "versions.json"), versions); err != nil {
+		return nil, err
+	}
+
+	comments, err := b.client.GetFileComments(f.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting comments")
+	}
+	fe.Comments = len(comments)
+	if err := writeJSON(s, path.Join(fe.Path, "comments.json"), comments); err != nil {
+		return nil, err
+	}
+
+	if b.opts.SkipImages {
+		return fe, nil
+	}
+	images, err := b.client.GetImageFills(f.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting image fills")
+	}
+	for ref, u := range images {
+		if u == "" {
+			continue
+		}
+		buf, err := b.download(u)
+		if err != nil {
+			return nil, errors.Wrapf(err, "downloading image %v", ref)
+		}
+		name := path.Join(fe.Path, "images", ref+imageExt(buf))
+		if err := s.write(name, buf); err != nil {
+			return nil, err
+		}
+		if fe.Images == nil {
+			fe.Images = make(map[string]string)
+		}
+		fe.Images[ref] = name
+	}
+	return fe, nil
+}
+
+func (b *backuper) download(u string) ([]byte, error) {
+	resp, err := b.httpClient.Get(u)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("unexpected status %v", resp.StatusCode)
+	}
+	return ioutil.ReadAll(resp.Body)
+}
+
+func imageExt(buf []byte) string {
+	switch http.DetectContentType(buf) {
+	case "image/png":
+		return ".png"
+	case "image/jpeg":
+		return ".jpg"
+	case "image/gif":
+		return ".gif"
+	case "image/webp":
+		return ".webp"
+	}
+	return ""
+}
+
+func writeJSON(s sink, name string, v interface{}) error {
+	buf, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return s.write(name, buf)
+}
@@ -0,0 +1,162 @@
+package backup
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/tmc/figma"
+)
+
+var png = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\x0dIHDR")
+
+type fakeAPI struct {
+	lastModified string
+	fileFetches  int
+	// Whether the image fill and the file have been deleted upstream.
+	noImages, noFiles bool
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/teams/t1/projects":
+		fmt.Fprint(w, `{"projects":[{"id":"p1","name":"Project"}]}`)
+	case "/projects/p1/files":
+		if f.noFiles {
+			fmt.Fprint(w, `{"files":[]}`)
+			return
+		}
+		fmt.Fprintf(w, `{"files":[{"key":"k1","name":"File","last_modified":%q}]}`, f.lastModified)
+	case "/files/k1":
+		f.fileFetches++
+		fmt.Fprint(w, `{"name":"File","document":{"id":"0:0","type":"DOCUMENT","children":[{"id":"1:0","type":"CANVAS","name":"Page"}]}}`)
+	case "/files/k1/versions":
+		fmt.Fprint(w, `{"versions":[{"id":"v1","label":"first"}]}`)
+	case "/files/k1/comments":
+		fmt.Fprint(w, `{"comments":[{"id":"c1","message":"hi"}]}`)
+	case "/files/k1/images":
+		if f.noImages {
+			fmt.Fprint(w, `{"meta":{"images":{}}}`)
+			return
+		}
+		fmt.Fprintf(w, `{"meta":{"images":{"ref1":"http://%s/blob/ref1"}}}`, r.Host)
+	case "/blob/ref1":
+		w.Write(png)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func TestTeam(t *testing.T) {
+	for _, name := range []string{"archive", "archive.zip"} {
+		t.Run(name, func(t *testing.T) {
+			api := &fakeAPI{lastModified: "2018-07-03T09:05:44Z"}
+			srv := httptest.NewServer(api)
+			defer srv.Close()
+			c, _ := figma.NewClient("token", figma.WithBaseURL(srv.URL+"/"))
+			dst := filepath.Join(t.TempDir(), name)
+
+			if _, err := Team(c, "t1", dst, nil); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := Team(c, "t1", dst, nil); err != nil {
+				t.Fatal(err)
+			}
+			if api.fileFetches != 1 {
+				t.Errorf("unchanged file fetched %d times, want 1", api.fileFetches)
+			}
+			api.lastModified = "2018-07-04T09:05:44Z"
+			if _, err := Team(c, "t1", dst, nil); err != nil {
+				t.Fatal(err)
+			}
+			if api.fileFetches != 2 {
+				t.Errorf("modified file fetched %d times, want 2", api.fileFetches)
+			}
+
+			a, err := Open(dst)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer a.Close()
+			f, err := a.File("k1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.Document.Children[0].GetName(); got != "Page" {
+				t.Errorf("got page %q, want %q", got, "Page")
+			}
+			comments, err := a.Comments("k1")
+			if err != nil || len(comments) != 1 {
+				t.Errorf("got comments %v, %v", comments, err)
+			}
+			img, err := a.Image("k1", "ref1")
+			if err != nil || string(img) != string(png) {
+				t.Errorf("got image %q, %v", img, err)
+			}
+			if fe, _ := a.Manifest().File("k1"); fe.LastModified != api.lastModified {
+				t.Errorf("got last modified %v, want %v", fe.LastModified, api.lastModified)
+			}
+		})
+	}
+}
+
+func TestTeamPrunesDirectory(t *testing.T) {
+	api := &fakeAPI{lastModified: "2018-07-03T09:05:44Z"}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	c, _ := figma.NewClient("token", figma.WithBaseURL(srv.URL+"/"))
+	dst := filepath.Join(t.TempDir(), "archive")
+	image := filepath.Join(dst, "files", "k1", "images", "ref1.png")
+
+	if _, err := Team(c, "t1", dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(image); err != nil {
+		t.Fatal(err)
+	}
+	api.lastModified = "2018-07-04T09:05:44Z"
+	api.noImages = true
+	if _, err := Team(c, "t1", dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(image); !os.IsNotExist(err) {
+		t.Errorf("image deleted upstream is still archived: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "files", "k1", "images")); !os.IsNotExist(err) {
+		t.Errorf("empty image directory is still archived: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "files", "k1", "file.json")); err != nil {
+		t.Errorf("refreshed file is missing: %v", err)
+	}
+
+	api.noFiles = true
+	if _, err := Team(c, "t1", dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(filepath.Join(dst, "files", "k1")); !os.IsNotExist(err) {
+		t.Errorf("file deleted upstream is still archived: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dst, ManifestName)); err != nil {
+		t.Errorf("manifest is missing: %v", err)
+	}
+}
+
+func TestTeamKeepsUnrelatedDirectory(t *testing.T) {
+	srv := httptest.NewServer(&fakeAPI{lastModified: "2018-07-03T09:05:44Z"})
+	defer srv.Close()
+	c, _ := figma.NewClient("token", figma.WithBaseURL(srv.URL+"/"))
+	dst := t.TempDir()
+	other := filepath.Join(dst, "notes.txt")
+	if err := os.WriteFile(other, []byte("mine"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Team(c, "t1", dst, nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(other); err != nil {
+		t.Errorf("file in a directory that held no archive was removed: %v", err)
+	}
+}
@@ -0,0 +1,2 @@
+// Package backup archives Figma team data to a directory or zip file and reads it back.
+package backup
@@ -0,0 +1,187 @@
+package backup
+
+import (
+	"archive/zip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// sink is a destination for archived data.
+type sink interface {
+	// write stores data under name.
+	write(name string, data []byte) error
+	// keep carries over everything under dir from the previous archive.
+	keep(dir string) error
+	close() error
+	abort()
+}
+
+func newSink(dst string) (sink, error) {
+	if isZip(dst) {
+		return newZipSink(dst)
+	}
+	return newDirSink(dst)
+}
+
+func isZip(name string) bool {
+	return strings.EqualFold(filepath.Ext(name), ".zip")
+}
+
+// dirSink writes into a directory. On close it removes everything in the
+// directory that was neither written nor kept, so that the directory holds
+// only what the archive refers to.
+type dirSink struct {
+	dir string
+	// Whether dir held an archive before; other directories are not pruned,
+	// so that a mistyped destination does not lose unrelated files.
+	prune bool
+	// Names written, and directories kept, during this run.
+	written map[string]bool
+	kept    []string
+}
+
+func newDirSink(dst string) (*dirSink, error) {
+	_, err := os.Stat(filepath.Join(dst, ManifestName))
+	prune := err == nil
+	if err := os.MkdirAll(dst, 0755); err != nil {
+		return nil, err
+	}
+	return &dirSink{dir: dst, prune: prune, written: make(map[string]bool)}, nil
+}
+
+func (d *dirSink) write(name string, data []byte) error {
+	p := filepath.Join(d.dir, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(p), 0755); err != nil {
+		return err
+	}
+	d.written[name] = true
+	return ioutil.WriteFile(p, data, 0644)
+}
+
+func (d *dirSink) keep(dir string) error {
+	d.kept = append(d.kept, strings.TrimSuffix(dir, "/")+"/")
+	return nil
+}
+
+func (d *dirSink) close() error {
+	if !d.prune {
+		return nil
+	}
+	var dirs []string
+	err := filepath.Walk(d.dir, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(d.dir, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		name := filepath.ToSlash(rel)
+		if info.IsDir() {
+			if d.isKept(name + "/") {
+				return filepath.SkipDir
+			}
+			dirs = append(dirs, p)
+			return nil
+		}
+		if d.written[name] || d.isKept(name) {
+			return nil
+		}
+		return os.Remove(p)
+	})
+	if err != nil {
+		return err
+	}
+	// Remove directories left empty, deepest first.
+	for i := len(dirs) - 1; i >= 0; i-- {
+		if entries, err := ioutil.ReadDir(dirs[i]); err == nil && len(entries) == 0 {
+			if err := os.Remove(dirs[i]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (d *dirSink) isKept(name string) bool {
+	for _, prefix := range d.kept {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func (d *dirSink) abort() {}
+
+// zipSink writes a new zip file next to dst and replaces dst with it on close.
+type zipSink struct {
+	dst  string
+	tmp  *os.File
+	w    *zip.Writer
+	prev *zip.ReadCloser
+}
+
+func newZipSink(dst string) (*zipSink, error) {
+	tmp, err := ioutil.TempFile(filepath.Dir(dst), filepath.Base(dst)+".*")
+	if err != nil {
+		return nil, err
+	}
+	s := &zipSink{dst: dst, tmp: tmp, w: zip.NewWriter(tmp)}
+	if prev, err := zip.OpenReader(dst); err == nil {
+		s.prev = prev
+	}
+	return s, nil
+}
+
+func (s *zipSink) write(name string, data []byte) error {
+	w, err := s.w.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+func (s *zipSink) keep(dir string) error {
+	if s.prev == nil {
+		return errors.Errorf("no previous archive to keep %v from", dir)
+	}
+	prefix := strings.TrimSuffix(dir, "/") + "/"
+	for _, f := range s.prev.File {
+		if !strings.HasPrefix(f.Name, prefix) {
+			continue
+		}
+		if err := s.w.Copy(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *zipSink) close() error {
+	if s.prev != nil {
+		s.prev.Close()
+	}
+	if err := s.w.Close(); err != nil {
+		s.abort()
+		return err
+	}
+	if err := s.tmp.Close(); err != nil {
+		os.Remove(s.tmp.Name())
+		return err
+	}
+	return os.Rename(s.tmp.Name(), s.dst)
+}
+
+func (s *zipSink) abort() {
+	if s.prev != nil {
+		s.prev.Close()
+	}
+	s.tmp.Close()
+	os.Remove(s.tmp.Name())
+}
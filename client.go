@@ -8,9 +8,11 @@ import (
 	"io/ioutil"
 	"net/http"
 	"net/url"
+	"strings"
 
 	"github.com/pkg/errors"
 	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
 )
 
 const defaultBaseURL = "https://api.figma.com/v1/"
@@ -124,10 +126,18 @@ func (c *Client) getFile(fileKey string) ([]byte, error) {
 	return c.get("files/%s", fileKey)
 }
 
+// GetFileJSON returns the undecoded JSON response for a given file key.
+func (c *Client) GetFileJSON(fileKey string) ([]byte, error) {
+	return c.getFile(fileKey)
+}
+
 // FileOptions allows configuration of the Get File request.
 type FileOptions struct {
 	GeometryPaths bool
 	Version       string
+	// PluginData lists the IDs of the plugins whose private data should be included on nodes,
+	// and may include "shared" to include shared plugin data.
+	PluginData []string
 }
 
 // GetFileWithOptions is similar to GetFile but allows more specific requests to be made.
@@ -146,9 +156,51 @@ func (c *Client) getFileWithOptions(fileKey string, opts FileOptions) ([]byte, e
 	if opts.GeometryPaths {
 		o.Set("geometry", "paths")
 	}
+	if len(opts.PluginData) > 0 {
+		o.Set("plugin_data", strings.Join(opts.PluginData, ","))
+	}
 	return c.get("files/%s?%s", fileKey, o.Encode())
 }
 
+// GetFileNodes returns the nodes of a file with the given IDs, with their descendants, keyed by ID.
+// IDs the file has no node for are left out.
+func (c *Client) GetFileNodes(fileKey string, ids ...string) (map[string]nodes.Node, error) {
+	b, err := c.getFileNodes(fileKey, ids)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		Nodes map[string]*struct {
+			Document json.RawMessage `json:"document"`
+		} `json:"nodes"`
+	}{}
+	if err := json.Unmarshal(b, &result); err != nil {
+		return nil, err
+	}
+	found := make(map[string]nodes.Node, len(result.Nodes))
+	for id, n := range result.Nodes {
+		if n == nil || n.Document == nil {
+			continue
+		}
+		list, err := json.Marshal([]json.RawMessage{n.Document})
+		if err != nil {
+			return nil, err
+		}
+		var c nodes.Children
+		if err := json.Unmarshal(list, &c); err != nil {
+			return nil, errors.Wrapf(err, "decoding node %s", id)
+		}
+		found[id] = c[0]
+	}
+	return found, nil
+}
+
+func (c *Client) getFileNodes(fileKey string, ids []string) ([]byte, error) {
+	o := url.Values{}
+	o.Set("ids", strings.Join(ids, ","))
+	return c.get("files/%s/nodes?%s", fileKey, o.Encode())
+}
+
 // GetImage gets an image from the Figma API.
 func (c *Client) GetImage(fileKey string, opts ImageOptions) (*Image, error) {
 	b, err := c.getImage(fileKey, opts)
@@ -172,6 +224,41 @@ func (c *Client) getImage(fileKey string, opts ImageOptions) ([]byte, error) {
 	return c.get("images/%s?%s", fileKey, o.Encode())
 }
 
+// GetImageFills returns download URLs for all images present in image fills in a file, keyed by image reference.
+func (c *Client) GetImageFills(fileKey string) (map[string]string, error) {
+	b, err := c.getImageFills(fileKey)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		Meta struct {
+			Images map[string]string `json:"images"`
+		} `json:"meta"`
+	}{}
+	return result.Meta.Images, json.Unmarshal(b, &result)
+}
+
+func (c *Client) getImageFills(fileKey string) ([]byte, error) {
+	return c.get("files/%s/images", fileKey)
+}
+
+// GetLocalVariables returns the variables defined in a file and the remote variables it uses.
+// It requires the file_variables:read scope, available on Enterprise plans.
+func (c *Client) GetLocalVariables(fileKey string) (*LocalVariables, error) {
+	b, err := c.getLocalVariables(fileKey)
+	if err != nil {
+		return nil, err
+	}
+	result := struct {
+		Meta LocalVariables `json:"meta"`
+	}{}
+	return &result.Meta, json.Unmarshal(b, &result)
+}
+
+func (c *Client) getLocalVariables(fileKey string) ([]byte, error) {
+	return c.get("files/%s/variables/local", fileKey)
+}
+
 // GetFileVersions returns a list of versions for a file.
 func (c *Client) GetFileVersions(fileKey string) ([]Version, error) {
 	b, err := c.getFileVersions(fileKey)
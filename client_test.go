@@ -3,6 +3,8 @@ package figma
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
@@ -122,12 +124,43 @@ func TestJsonEncodingDecoding(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		// figma has some undocumented differences from their docs, once those seem to be resolved this can be made a real assesrtion
-		//x1buf, _ := json.Marshal(x1)
-		//x2buf, _ := json.Marshal(x2)
-
 		if !cmp.Equal(x1, x2) {
-			t.Logf("json differs: %v", cmp.Diff(x1, x2))
+			t.Errorf("json differs: %v", cmp.Diff(x1, x2))
+		}
+	}
+}
+
+func TestStyleValuesUnused(t *testing.T) {
+	var f File
+	in := `{"styles": {
+			"1:1": {"name": "Used", "styleType": "FILL"},
+			"1:2": {"name": "Unused", "styleType": "FILL"},
+			"1:3": {"name": "Library", "styleType": "FILL"}},
+		"document": {"id": "0:0", "type": "DOCUMENT", "children": [{"id": "0:1", "type": "CANVAS", "children": [
+			{"id": "2:1", "type": "RECTANGLE", "styles": {"fill": "1:1"},
+				"fills": [{"type": "SOLID", "color": {"r": 1, "g": 0, "b": 0, "a": 1}}]}]}]}}`
+	if err := json.Unmarshal([]byte(in), &f); err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/files/KEY/nodes" || r.URL.Query().Get("ids") != "1:2,1:3" {
+			t.Errorf("unexpected request %s", r.URL)
 		}
+		fmt.Fprint(w, `{"nodes": {"1:2": {"document": {"id": "1:2", "type": "RECTANGLE",
+			"fills": [{"type": "SOLID", "color": {"r": 0, "g": 0, "b": 1, "a": 1}}]}}, "1:3": null}}`)
+	}))
+	defer srv.Close()
+	c, _ := NewClient("", WithBaseURL(srv.URL+"/"))
+
+	values, err := c.StyleValues("KEY", &f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []string
+	for _, v := range values {
+		got = append(got, fmt.Sprintf("%s %s %v", v.Name, v.NodeID, v.Paints[0].Color.B))
+	}
+	if want := []string{"Unused 1:2 1", "Used 2:1 0"}; !cmp.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
 	}
 }
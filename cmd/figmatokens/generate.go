@@ -0,0 +1,336 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/variables"
+)
+
+// A kind of token, which sets the prefix of its Go name.
+type kind struct {
+	prefix string
+	// Leading words of a style or variable name dropped because they repeat the
+	// prefix, so that "Colors/Brand" becomes ColorBrand and not ColorColorsBrand.
+	synonyms []string
+}
+
+var (
+	colorKind   = kind{"Color", []string{"color", "colors", "colour", "colours"}}
+	textKind    = kind{"Text", []string{"text", "type", "typography"}}
+	spacingKind = kind{"Spacing", []string{"spacing", "space", "spaces"}}
+	radiusKind  = kind{"Radius", []string{"radius", "radii", "corner", "corners"}}
+)
+
+// decl is a generated declaration.
+type decl struct {
+	kind *kind
+	name string
+	// The style or variable the declaration comes from, for sorting and comments.
+	source, id string
+	doc        string
+	value      string
+}
+
+// generate returns the source of a package declaring the tokens of the style
+// values and, if vars is not nil, of the variables.
+func generate(styles []figma.StyleValue, vars *figma.LocalVariables, pkg string) ([]byte, error) {
+	var decls []*decl
+	for _, v := range styles {
+		switch v.StyleType {
+		case figmatypes.StyleTypeFILL:
+			p, ok := solid(v.Paints)
+			if !ok {
+				continue
+			}
+			decls = append(decls, &decl{
+				kind:   &colorKind,
+				source: v.Name,
+				id:     v.ID,
+				doc:    fmt.Sprintf("the fill style %q, %s.", v.Name, hex(*p.Color, p.GetOpacity())) + description(v.Description),
+				value:  rgba(*p.Color, p.GetOpacity()),
+			})
+		case figmatypes.StyleTypeTEXT:
+			if v.Text == nil {
+				continue
+			}
+			decls = append(decls, &decl{
+				kind:   &textKind,
+				source: v.Name,
+				id:     v.ID,
+				doc:    fmt.Sprintf("the text style %q.", v.Name) + description(v.Description),
+				value:  typography(*v.Text),
+			})
+		}
+	}
+	if vars != nil {
+		vd, err := variableDecls(vars)
+		if err != nil {
+			return nil, err
+		}
+		decls = append(decls, vd...)
+	}
+	nameDecls(decls)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by figmatokens. DO NOT EDIT.\n\n")
+	fmt.Fprintf(&b, "package %s\n\n", pkg)
+	for _, d := range decls {
+		if d.kind == &colorKind {
+			fmt.Fprintf(&b, "import \"image/color\"\n\n")
+			break
+		}
+	}
+	fmt.Fprintf(&b, "%s\n", typographyType)
+	for _, k := range []*kind{&colorKind, &textKind, &spacingKind, &radiusKind} {
+		keyword := "var"
+		if k == &spacingKind || k == &radiusKind {
+			keyword = "const"
+		}
+		first := true
+		for _, d := range decls {
+			if d.kind != k {
+				continue
+			}
+			if first {
+				fmt.Fprintf(&b, "%s (\n", keyword)
+				first = false
+			} else {
+				b.WriteByte('\n')
+			}
+			fmt.Fprintf(&b, "// %s is %s\n%s = %s\n", d.name, d.doc, d.name, d.value)
+		}
+		if !first {
+			fmt.Fprintf(&b, ")\n\n")
+		}
+	}
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v\n%s", err, b.Bytes())
+	}
+	return src, nil
+}
+
+const typographyType = `// Typography is a text style. Sizes are in pixels.
+type Typography struct {
+	FontFamily string
+	FontSize   float64
+	FontWeight int
+	// The height of a line, or 0 for the font's default.
+	LineHeight    float64
+	LetterSpacing float64
+	Italic        bool
+}
+`
+
+// variableDecls returns declarations for the color variables and for the
+// number variables used for spacing or corner radii, with their values in
+// the default mode of their collection.
+func variableDecls(vars *figma.LocalVariables) ([]*decl, error) {
+	r := variables.New(vars)
+	var decls []*decl
+	for id, v := range vars.Variables {
+		if v.DeletedButReferenced {
+			continue
+		}
+		var k *kind
+		switch v.ResolvedType {
+		case figmatypes.VariableResolvedTypeCOLOR:
+			k = &colorKind
+		case figmatypes.VariableResolvedTypeFLOAT:
+			k = numberKind(v)
+		}
+		if k == nil {
+			continue
+		}
+		value, err := r.Resolve(nil, nil, id)
+		if err != nil {
+			return nil, err
+		}
+		d := &decl{
+			kind:   k,
+			source: v.Name,
+			id:     id,
+			doc:    fmt.Sprintf("the variable %q", v.Name),
+		}
+		if c, ok := vars.VariableCollections[v.VariableCollectionID]; ok {
+			d.doc += fmt.Sprintf(" in %q", c.Name)
+		}
+		switch {
+		case k == &colorKind && value.Color != nil:
+			d.doc += fmt.Sprintf(", %s.", hex(*value.Color, 1))
+			d.value = rgba(*value.Color, 1)
+		case k != &colorKind && value.Float != nil:
+			d.doc += "."
+			d.value = number(*value.Float)
+		default:
+			return nil, fmt.Errorf("variable %q has no %s value", v.Name, strings.ToLower(string(v.ResolvedType)))
+		}
+		d.doc += description(v.Description)
+		decls = append(decls, d)
+	}
+	return decls, nil
+}
+
+// numberKind returns the kind of a number variable, from its scopes or else
+// from the words of its name, or nil if it is neither a spacing nor a radius.
+func numberKind(v figmatypes.Variable) *kind {
+	if len(v.Scopes) > 0 {
+		radius, spacing := true, true
+		for _, s := range v.Scopes {
+			radius = radius && s == "CORNER_RADIUS"
+			spacing = spacing && (s == "GAP" || s == "WIDTH_HEIGHT")
+		}
+		switch {
+		case radius:
+			return &radiusKind
+		case spacing:
+			return &spacingKind
+		}
+	}
+	for _, w := range words(v.Name) {
+		switch strings.ToLower(w) {
+		case "radius", "radii", "corner", "corners", "rounded", "rounding":
+			return &radiusKind
+		case "spacing", "space", "spaces", "gap", "gaps", "padding", "margin", "margins", "inset":
+			return &spacingKind
+		}
+	}
+	return nil
+}
+
+// nameDecls sets the Go names of decls and sorts them by name. Declarations whose
+// names collide are numbered in the order of their source names and IDs, so
+// that the names are the same from one run to the next.
+func nameDecls(decls []*decl) {
+	for _, d := range decls {
+		d.name = identifier(d.kind, d.source)
+	}
+	sort.Slice(decls, func(i, j int) bool {
+		a, b := decls[i], decls[j]
+		if a.name != b.name {
+			return a.name < b.name
+		}
+		if a.source != b.source {
+			return a.source < b.source
+		}
+		return a.id < b.id
+	})
+	taken := make(map[string]bool)
+	for _, d := range decls {
+		taken[d.name] = true
+	}
+	for i := 0; i < len(decls); {
+		base, j, n := decls[i].name, i+1, 2
+		sep := ""
+		if endsInDigit(base) {
+			sep = "_"
+		}
+		for ; j < len(decls) && decls[j].name == base; j++ {
+			for taken[base+sep+strconv.Itoa(n)] {
+				n++
+			}
+			decls[j].name = base + sep + strconv.Itoa(n)
+			taken[decls[j].name] = true
+		}
+		i = j
+	}
+	sort.SliceStable(decls, func(i, j int) bool { return decls[i].name < decls[j].name })
+}
+
+// identifier returns the Go name of a style or variable: its kind's prefix and
+// the words of its name, capitalized. An underscore separates consecutive
+// numbers, so that "space/1.5" becomes Spacing1_5; it also comes before the
+// number given to a colliding name that ends in a digit.
+func identifier(k *kind, name string) string {
+	w := words(name)
+	if len(w) > 0 {
+		for _, s := range k.synonyms {
+			if strings.EqualFold(w[0], s) {
+				w = w[1:]
+				break
+			}
+		}
+	}
+	name = k.prefix
+	for _, word := range w {
+		r := []rune(word)
+		if unicode.IsDigit(r[0]) && endsInDigit(name) {
+			name += "_"
+		}
+		name += string(unicode.ToUpper(r[0])) + string(r[1:])
+	}
+	return name
+}
+
+func endsInDigit(s string) bool {
+	r, _ := utf8.DecodeLastRuneInString(s)
+	return unicode.IsDigit(r)
+}
+
+// words splits a name into runs of letters and digits.
+func words(name string) []string {
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// solid returns the paint of a fill style made of a single visible solid paint.
+func solid(paints []figmatypes.Paint) (figmatypes.Paint, bool) {
+	var shown []figmatypes.Paint
+	for _, p := range paints {
+		if p.IsVisible() {
+			shown = append(shown, p)
+		}
+	}
+	if len(shown) != 1 || shown[0].Type != figmatypes.PaintTypeSOLID || shown[0].Color == nil {
+		return figmatypes.Paint{}, false
+	}
+	return shown[0], true
+}
+
+// rgba returns a color.RGBA literal for c with its alpha multiplied by opacity.
+// Like all color.RGBA values, the channels are premultiplied by alpha.
+func rgba(c figmatypes.Color, opacity float64) string {
+	a := math.Max(0, math.Min(1, c.A*opacity))
+	return fmt.Sprintf("color.RGBA{R: 0x%02x, G: 0x%02x, B: 0x%02x, A: 0x%02x}",
+		figmatypes.Channel8(c.R*a), figmatypes.Channel8(c.G*a), figmatypes.Channel8(c.B*a), figmatypes.Channel8(a))
+}
+
+// hex returns c as #rrggbb, or #rrggbbaa if it is translucent.
+func hex(c figmatypes.Color, opacity float64) string {
+	s := fmt.Sprintf("#%02x%02x%02x", figmatypes.Channel8(c.R), figmatypes.Channel8(c.G), figmatypes.Channel8(c.B))
+	if a := figmatypes.Channel8(c.A * opacity); a != 0xff {
+		s += fmt.Sprintf("%02x", a)
+	}
+	return s
+}
+
+func typography(s figmatypes.TypeStyle) string {
+	return fmt.Sprintf("Typography{FontFamily: %q, FontSize: %s, FontWeight: %d, LineHeight: %s, LetterSpacing: %s, Italic: %t}",
+		s.FontFamily, number(s.FontSize), int(math.Round(s.FontWeight)), number(s.LineHeightPx), number(s.LetterSpacing), s.Italic)
+}
+
+// number formats v rounded to 4 decimal places, so that values computed in
+// the editor do not carry floating point noise into the output.
+func number(v float64) string {
+	return strconv.FormatFloat(math.Round(v*1e4)/1e4, 'g', -1, 64)
+}
+
+// description returns a description as further comment lines, if it is not empty.
+func description(s string) string {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return ""
+	}
+	return "\n//\n// " + strings.ReplaceAll(s, "\n", "\n// ")
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatest"
+	"github.com/tmc/figma/figmatypes"
+)
+
+// load builds a file with fill and text styles and its local variables, and
+// reads the variables back as saved from the API.
+func load(t *testing.T) ([]figma.StyleValue, *figma.LocalVariables) {
+	t.Helper()
+	b := figmatest.New("Tokens")
+	prim := b.Collection("Primitives")
+	prim.Variable("blue/500", figmatypes.VariableResolvedTypeCOLOR, figmatypes.Color{G: 0.4, B: 1, A: 1})
+	space := prim.Variable("space/1.5", figmatypes.VariableResolvedTypeFLOAT, 6).Scopes("GAP", "WIDTH_HEIGHT")
+	prim.Variable("layout/gap", figmatypes.VariableResolvedTypeFLOAT, space.Alias())
+	prim.Variable("radius/md", figmatypes.VariableResolvedTypeFLOAT, 8).Scopes("CORNER_RADIUS")
+	prim.Variable("weight/bold", figmatypes.VariableResolvedTypeFLOAT, 700).Scopes("FONT_WEIGHT")
+
+	primary := b.Style("Colors/Brand/Primary", figmatypes.StyleTypeFILL)
+	b.Describe(primary, "Main brand color")
+	faded := b.Style("Brand/Faded", figmatypes.StyleTypeFILL)
+	h1 := b.Style("Heading / H1", figmatypes.StyleTypeTEXT)
+	sunset := b.Style("Brand/Sunset", figmatypes.StyleTypeFILL)
+	// Named like primary, so that its identifier collides.
+	primary2 := b.Style("brand primary", figmatypes.StyleTypeFILL)
+
+	half := 0.5
+	frame := b.Canvas("Page").Frame("Frame", 0, 0, 1440, 900).Style(primary).Fill(figmatypes.Color{G: 0.4, B: 1, A: 1})
+	frame.Rectangle("Faded", 0, 0, 100, 100).Style(faded).
+		Paint(figmatypes.Paint{Type: figmatypes.PaintTypeSOLID, Opacity: &half, Color: &figmatypes.Color{R: 1, A: 1}})
+	frame.Text("Title", "Tokens", 0, 100, 400, 40).Style(h1).
+		TextStyle(figmatypes.TypeStyle{FontFamily: "Inter", FontWeight: 700, FontSize: 32, LetterSpacing: -0.5, LineHeightPx: 40, Italic: true})
+	frame.Rectangle("Sunset", 0, 200, 100, 100).Style(sunset).
+		Paint(figmatypes.Paint{Type: figmatypes.PaintTypeGRADIENT_LINEAR, GradientStops: []figmatypes.ColorStop{
+			{Position: 0, Color: figmatypes.Color{R: 1, G: 0.5, A: 1}},
+			{Position: 1, Color: figmatypes.Color{R: 0.5, B: 0.5, A: 1}},
+		}})
+	frame.Rectangle("White", 0, 300, 100, 100).Style(primary2).Fill(figmatypes.Color{R: 1, G: 1, B: 1, A: 1})
+	f := b.MustFile(t)
+
+	data, err := json.Marshal(map[string]interface{}{"meta": b.Variables()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "variables.json")
+	if err := os.WriteFile(path, data, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	lv, err := loadVariables(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f.StyleValues(), lv
+}
+
+func TestGenerate(t *testing.T) {
+	styles, lv := load(t)
+	src, err := generate(styles, lv, "brand")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := string(src)
+	for _, want := range []string{
+		"// Code generated by figmatokens. DO NOT EDIT.\n\npackage brand\n",
+		"import \"image/color\"",
+		"type Typography struct {",
+		"// ColorBrandPrimary is the fill style \"Colors/Brand/Primary\", #0066ff.\n\t//\n\t// Main brand color\n",
+		"ColorBrandPrimary = color.RGBA{R: 0x00, G: 0x66, B: 0xff, A: 0xff}",
+		"ColorBrandPrimary2 = color.RGBA{R: 0xff, G: 0xff, B: 0xff, A: 0xff}",
+		"// ColorBrandFaded is the fill style \"Brand/Faded\", #ff000080.",
+		"ColorBrandFaded = color.RGBA{R: 0x80, G: 0x00, B: 0x00, A: 0x80}",
+		"ColorBlue500 = color.RGBA{R: 0x00, G: 0x66, B: 0xff, A: 0xff}",
+		`TextHeadingH1 = Typography{FontFamily: "Inter", FontSize: 32, FontWeight: 700, LineHeight: 40, LetterSpacing: -0.5, Italic: true}`,
+		"const (\n\t// Spacing1_5 is the variable \"space/1.5\" in \"Primitives\".\n\tSpacing1_5 = 6\n",
+		"SpacingLayoutGap = 6",
+		"RadiusMd = 8",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("generated code does not contain %q:\n%s", want, got)
+		}
+	}
+	for _, unwanted := range []string{"Sunset", "Bold"} {
+		if strings.Contains(got, unwanted) {
+			t.Errorf("generated code contains %q:\n%s", unwanted, got)
+		}
+	}
+
+	// Variables are kept in a map, so a second run would differ if map order leaked.
+	for i := 0; i < 5; i++ {
+		again, err := generate(styles, lv, "brand")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(again, src) {
+			t.Fatalf("second run differs:\n%s\nfirst run:\n%s", again, src)
+		}
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tests := []struct {
+		kind *kind
+		name string
+		want string
+	}{
+		{&colorKind, "Brand/Primary", "ColorBrandPrimary"},
+		{&colorKind, "colors/gray/100", "ColorGray100"},
+		{&colorKind, "Colors", "Color"},
+		{&textKind, "Body - large (mobile)", "TextBodyLargeMobile"},
+		{&spacingKind, "space/1.5", "Spacing1_5"},
+		{&radiusKind, "corner/2xl", "Radius2xl"},
+		{&textKind, "Überschrift", "TextÜberschrift"},
+	}
+	for _, tt := range tests {
+		if got := identifier(tt.kind, tt.name); got != tt.want {
+			t.Errorf("identifier(%s, %q) = %q, want %q", tt.kind.prefix, tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+// Command figmatokens generates a Go package of design tokens from the styles
+// of a Figma file: color.RGBA values for solid fill styles and Typography
+// values for text styles. With variables, it also generates colors from color
+// variables and spacing and radius constants from number variables, taking
+// each variable's value in the default mode of its collection.
+//
+// Names are derived from style and variable names, so "Brand/Primary" becomes
+// ColorBrandPrimary, and declarations are ordered by name, so regenerating an
+// unchanged file produces identical output.
+//
+// The file is read from the API, using the token in $FIGMA_TOKEN, or from a
+// JSON snapshot of the file as the API returns it. A snapshot only holds the
+// values of the styles its nodes use, so styles defined in the file but not
+// used in it are only generated with -file:
+//
+//	//go:generate go run github.com/tmc/figma/cmd/figmatokens -file FILE_KEY
+//	//go:generate go run github.com/tmc/figma/cmd/figmatokens -json design.json -variables-json variables.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+
+	"github.com/tmc/figma"
+)
+
+func main() {
+	fileKey := flag.String("file", "", "key of the file to read from the API")
+	jsonPath := flag.String("json", "", "path of a JSON snapshot of the file")
+	vars := flag.Bool("variables", false, "also read the file's local variables from the API (requires -file)")
+	varsPath := flag.String("variables-json", "", "path of a JSON snapshot of the file's local variables")
+	pkg := flag.String("package", "", "package name (default: name of the current directory)")
+	output := flag.String("output", "tokens_gen.go", "output file name")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("figmatokens: ")
+
+	if (*fileKey == "") == (*jsonPath == "") {
+		log.Fatal("exactly one of -file and -json is required")
+	}
+	if *vars && *fileKey == "" {
+		log.Fatal("-variables requires -file")
+	}
+	if *pkg == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			log.Fatal(err)
+		}
+		*pkg = filepath.Base(wd)
+	}
+
+	var (
+		styles []figma.StyleValue
+		lv     *figma.LocalVariables
+		err    error
+	)
+	if *fileKey != "" {
+		c, err := figma.NewClient(os.Getenv("FIGMA_TOKEN"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		f, err := c.GetFile(*fileKey)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if styles, err = c.StyleValues(*fileKey, f); err != nil {
+			log.Fatal(err)
+		}
+		if *vars {
+			if lv, err = c.GetLocalVariables(*fileKey); err != nil {
+				log.Fatal(err)
+			}
+		}
+	} else {
+		f, err := loadFile(*jsonPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		styles = f.StyleValues()
+	}
+	if *varsPath != "" {
+		if lv, err = loadVariables(*varsPath); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	src, err := generate(styles, lv, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func loadFile(path string) (*figma.File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	f := new(figma.File)
+	if err := json.Unmarshal(data, f); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return f, nil
+}
+
+// loadVariables reads local variables saved either as the API response or as
+// its meta object alone.
+func loadVariables(path string) (*figma.LocalVariables, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var v struct {
+		figma.LocalVariables
+		Meta *figma.LocalVariables `json:"meta"`
+	}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	if v.Meta != nil {
+		return v.Meta, nil
+	}
+	return &v.LocalVariables, nil
+}
@@ -0,0 +1,104 @@
+package css
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Declaration is a CSS property and its value.
+type Declaration struct {
+	Property string
+	Value    string
+}
+
+// String returns the declaration as CSS, such as "color: #ff0000;".
+func (d Declaration) String() string {
+	return d.Property + ": " + d.Value + ";"
+}
+
+// Declarations is a list of declarations.
+type Declarations []Declaration
+
+// String returns the declarations as CSS, one per line.
+func (ds Declarations) String() string {
+	var b strings.Builder
+	for _, d := range ds {
+		b.WriteString(d.String())
+		b.WriteByte('\n')
+	}
+	return b.String()
+}
+
+// add appends a declaration unless its value is empty.
+func (ds *Declarations) add(property, value string) {
+	if value != "" {
+		*ds = append(*ds, Declaration{property, value})
+	}
+}
+
+// Color returns c with its alpha multiplied by opacity, as #rrggbb if it is
+// opaque and as rgba() otherwise.
+func Color(c figmatypes.Color, opacity float64) string {
+	r, g, b := figmatypes.Channel8(c.R), figmatypes.Channel8(c.G), figmatypes.Channel8(c.B)
+	if a := c.A * opacity; a < 1 {
+		return fmt.Sprintf("rgba(%d, %d, %d, %s)", r, g, b, num(math.Max(a, 0)))
+	}
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// num formats v with at most 2 decimal places, or 3 below 1.
+func num(v float64) string {
+	scale := 100.0
+	if math.Abs(v) < 1 {
+		scale = 1000
+	}
+	v = math.Round(v*scale) / scale
+	if v == 0 {
+		v = 0 // no negative zero
+	}
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
+
+func px(v float64) string {
+	return num(v) + "px"
+}
+
+func percent(v float64) string {
+	return num(v*100) + "%"
+}
+
+var blendModes = map[figmatypes.BlendMode]string{
+	figmatypes.BlendModeNORMAL:       "normal",
+	figmatypes.BlendModeDARKEN:       "darken",
+	figmatypes.BlendModeMULTIPLY:     "multiply",
+	figmatypes.BlendModeCOLOR_BURN:   "color-burn",
+	figmatypes.BlendModeLIGHTEN:      "lighten",
+	figmatypes.BlendModeSCREEN:       "screen",
+	figmatypes.BlendModeLINEAR_DODGE: "plus-lighter",
+	figmatypes.BlendModeCOLOR_DODGE:  "color-dodge",
+	figmatypes.BlendModeOVERLAY:      "overlay",
+	figmatypes.BlendModeSOFT_LIGHT:   "soft-light",
+	figmatypes.BlendModeHARD_LIGHT:   "hard-light",
+	figmatypes.BlendModeDIFFERENCE:   "difference",
+	figmatypes.BlendModeEXCLUSION:    "exclusion",
+	figmatypes.BlendModeHUE:          "hue",
+	figmatypes.BlendModeSATURATION:   "saturation",
+	figmatypes.BlendModeCOLOR:        "color",
+	figmatypes.BlendModeLUMINOSITY:   "luminosity",
+}
+
+// BlendMode returns the mix-blend-mode or background-blend-mode value for m.
+// It reports false for PASS_THROUGH, which is the default for groups and needs
+// no declaration, and for LINEAR_BURN, which CSS cannot express. LINEAR_DODGE
+// becomes plus-lighter, which mix-blend-mode supports but background-blend-mode does not.
+func BlendMode(m figmatypes.BlendMode) (string, bool) {
+	if m == "" {
+		return "normal", true
+	}
+	v, ok := blendModes[m]
+	return v, ok
+}
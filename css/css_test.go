@@ -0,0 +1,202 @@
+package css
+
+import (
+	"testing"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatest"
+	"github.com/tmc/figma/figmatypes"
+)
+
+var (
+	red  = figmatypes.Color{R: 1, A: 1}
+	blue = figmatypes.Color{B: 1, A: 1}
+	half = 0.5
+)
+
+func gradient(t figmatypes.PaintType, handles ...figmatypes.Vector) figmatypes.Paint {
+	return figmatypes.Paint{
+		Type:                    t,
+		GradientHandlePositions: handles,
+		GradientStops:           []figmatypes.ColorStop{{Position: 0, Color: red}, {Position: 1, Color: blue}},
+	}
+}
+
+func TestColor(t *testing.T) {
+	if got, want := Color(red, 1), "#ff0000"; got != want {
+		t.Errorf("Color = %q, want %q", got, want)
+	}
+	if got, want := Color(figmatypes.Color{R: 1, G: 1, B: 1, A: 0.5}, 0.5), "rgba(255, 255, 255, 0.25)"; got != want {
+		t.Errorf("Color = %q, want %q", got, want)
+	}
+}
+
+func TestBackground(t *testing.T) {
+	tests := []struct {
+		name  string
+		paint figmatypes.Paint
+		size  figmatypes.Vector
+		want  string
+	}{
+		{"vertical", gradient(figmatypes.PaintTypeGRADIENT_LINEAR, figmatypes.Vector{X: 0.5}, figmatypes.Vector{X: 0.5, Y: 1}, figmatypes.Vector{}),
+			figmatypes.Vector{X: 100, Y: 50}, "linear-gradient(180deg, #ff0000 0%, #0000ff 100%)"},
+		{"diagonal", gradient(figmatypes.PaintTypeGRADIENT_LINEAR, figmatypes.Vector{}, figmatypes.Vector{X: 1, Y: 1}),
+			figmatypes.Vector{X: 100, Y: 100}, "linear-gradient(135deg, #ff0000 0%, #0000ff 100%)"},
+		// Stops at a quarter and three quarters of the width of a wide box.
+		{"partial", gradient(figmatypes.PaintTypeGRADIENT_LINEAR, figmatypes.Vector{X: 0.25, Y: 0.5}, figmatypes.Vector{X: 0.75, Y: 0.5}, figmatypes.Vector{X: 0.25, Y: 1}),
+			figmatypes.Vector{X: 200, Y: 100}, "linear-gradient(90deg, #ff0000 25%, #0000ff 75%)"},
+		{"radial", gradient(figmatypes.PaintTypeGRADIENT_RADIAL, figmatypes.Vector{X: 0.5, Y: 0.5}, figmatypes.Vector{X: 1, Y: 0.5}, figmatypes.Vector{X: 0.5, Y: 1}),
+			figmatypes.Vector{X: 200, Y: 100}, "radial-gradient(50% 50% at 50% 50%, #ff0000 0%, #0000ff 100%)"},
+		{"angular", gradient(figmatypes.PaintTypeGRADIENT_ANGULAR, figmatypes.Vector{X: 0.5, Y: 0.5}, figmatypes.Vector{X: 1, Y: 0.5}, figmatypes.Vector{X: 0.5, Y: 1}),
+			figmatypes.Vector{X: 100, Y: 100}, "conic-gradient(from 90deg at 50% 50%, #ff0000 0%, #0000ff 100%)"},
+		{"diamond", gradient(figmatypes.PaintTypeGRADIENT_DIAMOND, figmatypes.Vector{X: 0.5, Y: 0.5}, figmatypes.Vector{X: 1, Y: 0.5}, figmatypes.Vector{X: 0.5, Y: 1}),
+			figmatypes.Vector{X: 100, Y: 100}, "linear-gradient(135deg, #ff0000 0%, #0000ff 50%) 50px 50px / 50px 50px no-repeat, " +
+				"linear-gradient(225deg, #ff0000 0%, #0000ff 50%) 0px 50px / 50px 50px no-repeat, " +
+				"linear-gradient(315deg, #ff0000 0%, #0000ff 50%) 0px 0px / 50px 50px no-repeat, " +
+				"linear-gradient(45deg, #ff0000 0%, #0000ff 50%) 50px 0px / 50px 50px no-repeat"},
+		{"coincident", gradient(figmatypes.PaintTypeGRADIENT_LINEAR, figmatypes.Vector{X: 0.5, Y: 0.5}, figmatypes.Vector{X: 0.5, Y: 0.5}, figmatypes.Vector{X: 0.5, Y: 0.5}),
+			figmatypes.Vector{X: 100, Y: 100}, "linear-gradient(180deg, #ff0000 50%, #0000ff 50%)"},
+	}
+	for _, tt := range tests {
+		ds := Background([]figmatypes.Paint{tt.paint}, tt.size)
+		if len(ds) != 1 || ds[0].Value != tt.want {
+			t.Errorf("%s: Background = %v\nwant %s", tt.name, ds, tt.want)
+		}
+	}
+}
+
+func TestBackgroundLayers(t *testing.T) {
+	hidden := false
+	paints := []figmatypes.Paint{
+		{Type: figmatypes.PaintTypeSOLID, Color: &blue},
+		{Type: figmatypes.PaintTypeIMAGE, ImageRef: "abc"},
+		{Type: figmatypes.PaintTypeSOLID, Color: &red, Visible: &hidden},
+		{Type: figmatypes.PaintTypeSOLID, Color: &red, Opacity: &half, BlendMode: figmatypes.BlendModeMULTIPLY},
+	}
+	got := Background(paints, figmatypes.Vector{X: 10, Y: 10}).String()
+	want := "background: linear-gradient(rgba(255, 0, 0, 0.5), rgba(255, 0, 0, 0.5)), #0000ff;\n" +
+		"background-blend-mode: multiply, normal;\n"
+	if got != want {
+		t.Errorf("Background =\n%s\nwant\n%s", got, want)
+	}
+	if got := TextFill(paints[:1], figmatypes.Vector{}).String(); got != "color: #0000ff;\n" {
+		t.Errorf("TextFill = %q", got)
+	}
+
+	// Modes background-blend-mode cannot express are drawn normally.
+	for _, m := range []figmatypes.BlendMode{figmatypes.BlendModeLINEAR_BURN, figmatypes.BlendModeLINEAR_DODGE, "FUTURE"} {
+		paints := []figmatypes.Paint{
+			{Type: figmatypes.PaintTypeSOLID, Color: &blue},
+			{Type: figmatypes.PaintTypeSOLID, Color: &red, BlendMode: m},
+		}
+		got := Background(paints, figmatypes.Vector{X: 10, Y: 10}).String()
+		if want := "background: linear-gradient(#ff0000, #ff0000), #0000ff;\n"; got != want {
+			t.Errorf("Background with %s =\n%s\nwant\n%s", m, got, want)
+		}
+	}
+}
+
+func TestEffects(t *testing.T) {
+	effects := []figmatypes.Effect{
+		{Type: figmatypes.EffectTypeDROP_SHADOW, Color: figmatypes.Color{A: 0.25}, Offset: figmatypes.Vector{Y: 4}, Radius: 8, Spread: 2},
+		{Type: figmatypes.EffectTypeINNER_SHADOW, Color: red, Radius: 1},
+		{Type: figmatypes.EffectTypeLAYER_BLUR, Radius: 10},
+	}
+	want := "box-shadow: inset 0px 0px 1px 0px #ff0000, 0px 4px 8px 2px rgba(0, 0, 0, 0.25);\nfilter: blur(5px);\n"
+	if got := Effects(effects, false).String(); got != want {
+		t.Errorf("Effects =\n%s\nwant\n%s", got, want)
+	}
+	if got, want := TextShadow(effects), "0px 4px 8px rgba(0, 0, 0, 0.25)"; got != want {
+		t.Errorf("TextShadow = %q, want %q", got, want)
+	}
+}
+
+func TestFont(t *testing.T) {
+	s := figmatypes.TypeStyle{
+		FontFamily:          `Inter "Display"`,
+		FontSize:            32,
+		FontWeight:          700,
+		Italic:              true,
+		LineHeightPx:        40,
+		LetterSpacing:       -0.64,
+		TextAlignHorizontal: figmatypes.TextAlignHorizontalCENTER,
+		TextCase:            figmatypes.TextCaseUPPER,
+		TextDecoration:      figmatypes.TextDecorationUNDERLINE,
+	}
+	want := `font-family: "Inter \"Display\"";
+font-size: 32px;
+font-weight: 700;
+font-style: italic;
+line-height: 40px;
+letter-spacing: -0.64px;
+text-align: center;
+text-transform: uppercase;
+text-decoration: underline;
+`
+	if got := Font(s).String(); got != want {
+		t.Errorf("Font =\n%s\nwant\n%s", got, want)
+	}
+
+	// Only a percentage of the font size maps to a CSS percentage.
+	if got := Font(figmatypes.TypeStyle{LineHeightPercent: 120}).String(); got != "" {
+		t.Errorf("Font with lineHeightPercent = %q", got)
+	}
+	if got := Font(figmatypes.TypeStyle{LineHeightPercentFontSize: 150}).String(); got != "line-height: 150%;\n" {
+		t.Errorf("Font with lineHeightPercentFontSize = %q", got)
+	}
+}
+
+func TestBlendMode(t *testing.T) {
+	if v, ok := BlendMode(figmatypes.BlendModeCOLOR_DODGE); !ok || v != "color-dodge" {
+		t.Errorf("BlendMode(COLOR_DODGE) = %q, %v", v, ok)
+	}
+	if _, ok := BlendMode(figmatypes.BlendModePASS_THROUGH); ok {
+		t.Error("BlendMode(PASS_THROUGH) reported a value")
+	}
+}
+
+func TestStylesheet(t *testing.T) {
+	b := figmatest.New("Styles")
+	primary := b.Style("Brand/Primary", figmatypes.StyleTypeFILL)
+	h1 := b.Style("Heading / H1", figmatypes.StyleTypeTEXT)
+	elevation := b.Style("Elevation/1", figmatypes.StyleTypeEFFECT)
+	sunset := b.Style("Sunset", figmatypes.StyleTypeFILL)
+	// Declares --heading-h1-font-size, like the font size of "Heading / H1".
+	h1Size := b.Style("Heading/H1 font size", figmatypes.StyleTypeFILL)
+	page := b.Canvas("Page")
+	page.Frame("Card", 0, 0, 100, 100).Style(primary).Style(elevation).
+		Paint(figmatypes.Paint{Type: figmatypes.PaintTypeSOLID, Color: &figmatypes.Color{G: 0.4, B: 1, A: 1}}).
+		Effect(figmatypes.Effect{Type: figmatypes.EffectTypeDROP_SHADOW, Color: figmatypes.Color{A: 0.5}, Offset: figmatypes.Vector{Y: 2}, Radius: 4})
+	page.Text("Title", "Title", 0, 100, 100, 40).Style(h1).
+		TextStyle(figmatypes.TypeStyle{FontFamily: "Inter", FontWeight: 700, FontSize: 32})
+	page.Rectangle("Sunset", 0, 200, 200, 100).Style(sunset).
+		Paint(gradient(figmatypes.PaintTypeGRADIENT_LINEAR, figmatypes.Vector{Y: 0.5}, figmatypes.Vector{X: 1, Y: 0.5}, figmatypes.Vector{Y: 1}))
+	page.Rectangle("Size", 0, 300, 10, 10).Style(h1Size).Fill(red)
+	f := b.MustFile(t)
+
+	want := `:root {
+  --brand-primary: #0066ff;
+  --elevation-1-box-shadow: 0px 2px 4px 0px rgba(0, 0, 0, 0.5);
+  --heading-h1-font-family: "Inter";
+  --heading-h1-font-size: 32px;
+  --heading-h1-font-weight: 700;
+  --heading-h1-font-size-2: #ff0000;
+  --sunset: linear-gradient(90deg, #ff0000 0%, #0000ff 100%);
+}
+`
+	if got := Stylesheet(f); got != want {
+		t.Errorf("Stylesheet =\n%s\nwant\n%s", got, want)
+	}
+
+	// Values given with Styles replace those of the file's nodes; a text
+	// style without a value is left out.
+	styles := []figma.StyleValue{
+		{Style: figmatypes.Style{Name: "Unused", StyleType: figmatypes.StyleTypeFILL}, ID: "S:9", NodeID: "9:9",
+			Paints: []figmatypes.Paint{{Type: figmatypes.PaintTypeSOLID, Color: &blue}}},
+		{Style: figmatypes.Style{Name: "Body", StyleType: figmatypes.StyleTypeTEXT}, ID: "S:10"},
+	}
+	want = ":root {\n  --unused: #0000ff;\n}\n"
+	if got := Stylesheet(f, Styles(styles)); got != want {
+		t.Errorf("Stylesheet with Styles =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,14 @@
+// Package css translates paints, effects and type styles into CSS.
+//
+// Background converts fills, including linear, radial, angular and diamond
+// gradients placed by their handle positions in the node's object space;
+// BoxShadow, TextShadow, Filter and BackdropFilter convert effects; Font
+// converts a type style; and BlendMode maps blend modes to mix-blend-mode and
+// background-blend-mode values. Stylesheet writes the styles of a file as CSS
+// custom properties.
+//
+// CSS cannot express every Figma paint. Image paints are left out, diamond
+// gradients are drawn as four linear gradients, one per quadrant, and
+// rotated or skewed radial and diamond gradients are approximated by their
+// axis-aligned equivalents.
+package css
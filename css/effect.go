@@ -0,0 +1,76 @@
+package css
+
+import (
+	"strings"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Effects returns the declarations for the effects of a node: shadows, as
+// box-shadow or, for text, text-shadow, and layer and background blurs.
+func Effects(effects []figmatypes.Effect, text bool) Declarations {
+	var ds Declarations
+	if text {
+		ds.add("text-shadow", TextShadow(effects))
+	} else {
+		ds.add("box-shadow", BoxShadow(effects))
+	}
+	ds.add("filter", Filter(effects))
+	ds.add("backdrop-filter", BackdropFilter(effects))
+	return ds
+}
+
+// BoxShadow returns the box-shadow value for the visible drop and inner
+// shadows among effects, or "" if there are none. Like paints, Figma lists
+// effects from the bottom up, so the order is reversed.
+func BoxShadow(effects []figmatypes.Effect) string {
+	var shadows []string
+	for i := len(effects) - 1; i >= 0; i-- {
+		fx := effects[i]
+		if !fx.IsVisible() {
+			continue
+		}
+		s := px(fx.Offset.X) + " " + px(fx.Offset.Y) + " " + px(fx.Radius) + " " + px(fx.Spread) + " " + Color(fx.Color, 1)
+		switch fx.Type {
+		case figmatypes.EffectTypeDROP_SHADOW:
+			shadows = append(shadows, s)
+		case figmatypes.EffectTypeINNER_SHADOW:
+			shadows = append(shadows, "inset "+s)
+		}
+	}
+	return strings.Join(shadows, ", ")
+}
+
+// TextShadow returns the text-shadow value for the visible drop shadows among
+// effects, or "" if there are none. Text shadows have no spread.
+func TextShadow(effects []figmatypes.Effect) string {
+	var shadows []string
+	for i := len(effects) - 1; i >= 0; i-- {
+		fx := effects[i]
+		if fx.IsVisible() && fx.Type == figmatypes.EffectTypeDROP_SHADOW {
+			shadows = append(shadows, px(fx.Offset.X)+" "+px(fx.Offset.Y)+" "+px(fx.Radius)+" "+Color(fx.Color, 1))
+		}
+	}
+	return strings.Join(shadows, ", ")
+}
+
+// Filter returns the filter value for a visible layer blur, or "".
+func Filter(effects []figmatypes.Effect) string {
+	return blur(effects, figmatypes.EffectTypeLAYER_BLUR)
+}
+
+// BackdropFilter returns the backdrop-filter value for a visible background blur, or "".
+func BackdropFilter(effects []figmatypes.Effect) string {
+	return blur(effects, figmatypes.EffectTypeBACKGROUND_BLUR)
+}
+
+// blur returns a blur() of the first visible effect of type t. Figma's blur
+// radius is twice the standard deviation CSS expects.
+func blur(effects []figmatypes.Effect, t figmatypes.EffectType) string {
+	for _, fx := range effects {
+		if fx.IsVisible() && fx.Type == t {
+			return "blur(" + px(fx.Radius/2) + ")"
+		}
+	}
+	return ""
+}
@@ -0,0 +1,76 @@
+package css
+
+import (
+	"strings"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Font returns the declarations for a type style: the font, line height,
+// letter spacing, alignment, case, decoration and paragraph indent. Fills
+// are left to TextFill.
+func Font(s figmatypes.TypeStyle) Declarations {
+	var ds Declarations
+	if s.FontFamily != "" {
+		ds.add("font-family", quote(s.FontFamily))
+	}
+	if s.FontSize > 0 {
+		ds.add("font-size", px(s.FontSize))
+	}
+	if s.FontWeight > 0 {
+		ds.add("font-weight", num(s.FontWeight))
+	} else if s.IsBold() {
+		ds.add("font-weight", "bold")
+	}
+	if s.IsItalic() {
+		ds.add("font-style", "italic")
+	}
+	switch {
+	case s.LineHeightPx > 0:
+		ds.add("line-height", px(s.LineHeightPx))
+	case s.LineHeightPercentFontSize > 0:
+		// CSS percentages are of the font size; LineHeightPercent is of the
+		// font's normal line height, which depends on its metrics.
+		ds.add("line-height", num(s.LineHeightPercentFontSize)+"%")
+	}
+	if s.LetterSpacing != 0 {
+		ds.add("letter-spacing", px(s.LetterSpacing))
+	}
+	switch s.TextAlignHorizontal {
+	case figmatypes.TextAlignHorizontalLEFT:
+		ds.add("text-align", "left")
+	case figmatypes.TextAlignHorizontalRIGHT:
+		ds.add("text-align", "right")
+	case figmatypes.TextAlignHorizontalCENTER:
+		ds.add("text-align", "center")
+	case figmatypes.TextAlignHorizontalJUSTIFIED:
+		ds.add("text-align", "justify")
+	}
+	switch s.TextCase {
+	case figmatypes.TextCaseUPPER:
+		ds.add("text-transform", "uppercase")
+	case figmatypes.TextCaseLOWER:
+		ds.add("text-transform", "lowercase")
+	case figmatypes.TextCaseTITLE:
+		ds.add("text-transform", "capitalize")
+	case figmatypes.TextCaseSMALL_CAPS:
+		ds.add("font-variant-caps", "small-caps")
+	case figmatypes.TextCaseSMALL_CAPS_FORCED:
+		ds.add("font-variant-caps", "all-small-caps")
+	}
+	switch s.TextDecoration {
+	case figmatypes.TextDecorationUNDERLINE:
+		ds.add("text-decoration", "underline")
+	case figmatypes.TextDecorationSTRIKETHROUGH:
+		ds.add("text-decoration", "line-through")
+	}
+	if s.ParagraphIndent != 0 {
+		ds.add("text-indent", px(s.ParagraphIndent))
+	}
+	return ds
+}
+
+// quote returns s as a CSS string.
+func quote(s string) string {
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
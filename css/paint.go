@@ -0,0 +1,210 @@
+package css
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Background returns the background declarations for fills painted on a node
+// of the given size. Figma lists paints from the bottom up and CSS lists
+// background layers from the top down, so the order is reversed. Hidden and
+// image paints are left out; the result is empty if no paint remains.
+func Background(paints []figmatypes.Paint, size figmatypes.Vector) Declarations {
+	var layers, blends []string
+	blended := false
+	for i := len(paints) - 1; i >= 0; i-- {
+		p := paints[i]
+		if !p.IsVisible() {
+			continue
+		}
+		ls := paintLayers(p, size)
+		blend, ok := BlendMode(p.BlendMode)
+		if !ok || blend == "plus-lighter" {
+			// Paints CSS cannot blend, and LINEAR_DODGE, which only
+			// mix-blend-mode supports, are drawn normally.
+			blend = "normal"
+		}
+		blended = blended || blend != "normal"
+		for range ls {
+			blends = append(blends, blend)
+		}
+		layers = append(layers, ls...)
+	}
+	if len(layers) == 0 {
+		return nil
+	}
+	// The bottom layer may be a plain color.
+	if p := bottomPaint(paints); p != nil && p.Type == figmatypes.PaintTypeSOLID && p.Color != nil {
+		layers[len(layers)-1] = Color(*p.Color, p.GetOpacity())
+	}
+	var ds Declarations
+	ds.add("background", strings.Join(layers, ", "))
+	if blended {
+		ds.add("background-blend-mode", strings.Join(blends, ", "))
+	}
+	return ds
+}
+
+// TextFill returns the declarations that paint text with fills: a color for a
+// single solid paint, and a background clipped to the text otherwise.
+func TextFill(paints []figmatypes.Paint, size figmatypes.Vector) Declarations {
+	var shown []figmatypes.Paint
+	for _, p := range paints {
+		if p.IsVisible() {
+			shown = append(shown, p)
+		}
+	}
+	if len(shown) == 1 && shown[0].Type == figmatypes.PaintTypeSOLID && shown[0].Color != nil {
+		return Declarations{{"color", Color(*shown[0].Color, shown[0].GetOpacity())}}
+	}
+	ds := Background(paints, size)
+	if len(ds) == 0 {
+		return nil
+	}
+	return append(ds,
+		Declaration{"-webkit-background-clip", "text"},
+		Declaration{"background-clip", "text"},
+		Declaration{"color", "transparent"},
+	)
+}
+
+// bottomPaint returns the visible paint drawn first, if CSS can draw it.
+func bottomPaint(paints []figmatypes.Paint) *figmatypes.Paint {
+	for i, p := range paints {
+		if p.IsVisible() && len(paintLayers(p, figmatypes.Vector{X: 1, Y: 1})) > 0 {
+			return &paints[i]
+		}
+	}
+	return nil
+}
+
+// paintLayers returns the background layers drawing p.
+func paintLayers(p figmatypes.Paint, size figmatypes.Vector) []string {
+	a := p.GetOpacity()
+	switch p.Type {
+	case figmatypes.PaintTypeSOLID:
+		if p.Color == nil {
+			return nil
+		}
+		c := Color(*p.Color, a)
+		return []string{fmt.Sprintf("linear-gradient(%s, %s)", c, c)}
+	case figmatypes.PaintTypeGRADIENT_LINEAR, figmatypes.PaintTypeGRADIENT_RADIAL,
+		figmatypes.PaintTypeGRADIENT_ANGULAR, figmatypes.PaintTypeGRADIENT_DIAMOND:
+	default:
+		return nil
+	}
+	if len(p.GradientStops) == 0 || len(p.GradientHandlePositions) < 2 {
+		return nil
+	}
+	w, h := size.X, size.Y
+	if w <= 0 || h <= 0 {
+		w, h = 1, 1
+	}
+	// Handle positions in pixels. The third handle defaults to the second
+	// rotated by 90° about the first, in object space.
+	hp := p.GradientHandlePositions
+	p0, p1 := vec{hp[0].X * w, hp[0].Y * h}, vec{hp[1].X * w, hp[1].Y * h}
+	var p2 vec
+	if len(hp) > 2 {
+		p2 = vec{hp[2].X * w, hp[2].Y * h}
+	} else {
+		d := vec{hp[1].X - hp[0].X, hp[1].Y - hp[0].Y}
+		p2 = vec{(hp[0].X - d.y) * w, (hp[0].Y + d.x) * h}
+	}
+	switch p.Type {
+	case figmatypes.PaintTypeGRADIENT_LINEAR:
+		return []string{linear(p0, p1, p2.sub(p0), w, h, p.GradientStops, a)}
+	case figmatypes.PaintTypeGRADIENT_RADIAL:
+		return []string{fmt.Sprintf("radial-gradient(%s %s at %s %s, %s)",
+			percent(p1.sub(p0).len()/w), percent(p2.sub(p0).len()/h),
+			percent(p0.x/w), percent(p0.y/h), stops(p.GradientStops, a))}
+	case figmatypes.PaintTypeGRADIENT_ANGULAR:
+		return []string{fmt.Sprintf("conic-gradient(from %sdeg at %s %s, %s)",
+			num(angle(p1.sub(p0))), percent(p0.x/w), percent(p0.y/h), stops(p.GradientStops, a))}
+	}
+	return diamond(p0, p1.sub(p0).len(), p2.sub(p0).len(), w, h, p.GradientStops, a)
+}
+
+// linear returns a linear-gradient() for a box of w by h pixels whose
+// gradient starts at p0 and reaches its end at p1, with lines of equal color
+// running along iso.
+func linear(p0, p1, iso vec, w, h float64, cs []figmatypes.ColorStop, opacity float64) string {
+	// The CSS gradient line is perpendicular to the lines of equal color and
+	// runs through the center of the box, long enough to reach its corners.
+	n := vec{-iso.y, iso.x}
+	if n.len() == 0 {
+		n = p1.sub(p0)
+	}
+	if n.len() == 0 {
+		// All handles coincide: the gradient runs vertically.
+		n = vec{0, 1}
+	}
+	n = n.scale(1 / n.len())
+	span := p1.sub(p0).dot(n)
+	if span < 0 {
+		n, span = n.scale(-1), -span
+	}
+	length := math.Abs(w*n.x) + math.Abs(h*n.y)
+	start := vec{w / 2, h / 2}.sub(n.scale(length / 2))
+	base := p0.sub(start).dot(n)
+	var parts []string
+	for _, s := range cs {
+		pos := (base + s.Position*span) / length
+		parts = append(parts, Color(s.Color, opacity)+" "+percent(pos))
+	}
+	return fmt.Sprintf("linear-gradient(%sdeg, %s)", num(angle(n)), strings.Join(parts, ", "))
+}
+
+// diamond returns four background layers, one per quadrant around the center
+// c, each a linear gradient reaching its end a pixels away horizontally and b
+// pixels vertically.
+func diamond(c vec, a, b, w, h float64, cs []figmatypes.ColorStop, opacity float64) []string {
+	var layers []string
+	for _, q := range []struct{ sx, sy float64 }{{1, 1}, {-1, 1}, {-1, -1}, {1, -1}} {
+		x, qw := c.x, w-c.x
+		if q.sx < 0 {
+			x, qw = 0, c.x
+		}
+		y, qh := c.y, h-c.y
+		if q.sy < 0 {
+			y, qh = 0, c.y
+		}
+		if qw <= 0 || qh <= 0 || a == 0 || b == 0 {
+			continue
+		}
+		origin := vec{c.x - x, c.y - y}
+		g := linear(origin, origin.add(vec{q.sx * a, 0}), vec{-q.sx * a, q.sy * b}, qw, qh, cs, opacity)
+		layers = append(layers, fmt.Sprintf("%s %s %s / %s %s no-repeat", g, px(x), px(y), px(qw), px(qh)))
+	}
+	return layers
+}
+
+// stops returns the color stops of a gradient at their positions along it.
+func stops(cs []figmatypes.ColorStop, opacity float64) string {
+	parts := make([]string, len(cs))
+	for i, s := range cs {
+		parts[i] = Color(s.Color, opacity) + " " + percent(s.Position)
+	}
+	return strings.Join(parts, ", ")
+}
+
+// angle returns the CSS angle in degrees of direction d: 0 points up and
+// angles grow clockwise.
+func angle(d vec) float64 {
+	a := math.Atan2(d.x, -d.y) * 180 / math.Pi
+	if a < 0 {
+		a += 360
+	}
+	return a
+}
+
+type vec struct{ x, y float64 }
+
+func (v vec) add(u vec) vec       { return vec{v.x + u.x, v.y + u.y} }
+func (v vec) sub(u vec) vec       { return vec{v.x - u.x, v.y - u.y} }
+func (v vec) scale(f float64) vec { return vec{v.x * f, v.y * f} }
+func (v vec) dot(u vec) float64   { return v.x*u.x + v.y*u.y }
+func (v vec) len() float64        { return math.Hypot(v.x, v.y) }
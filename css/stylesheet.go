@@ -0,0 +1,152 @@
+package css
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// Option configures Stylesheet.
+type Option func(*options)
+
+type options struct {
+	// Style values given with Styles, or nil to use File.StyleValues.
+	styles []figma.StyleValue
+}
+
+// Styles sets the style values to declare instead of those returned by
+// File.StyleValues. Use it with the values returned by Client.StyleValues to
+// declare styles that no node of the file uses.
+func Styles(values []figma.StyleValue) Option {
+	return func(o *options) {
+		o.styles = values
+	}
+}
+
+// Stylesheet returns a :root rule declaring a custom property for each
+// style of f with a value, named after the style: "Brand/Primary" becomes
+// --brand-primary. Fill styles declare the background; text, effect and
+// grid styles declare one property per CSS property, such as
+// --heading-h1-font-size or --elevation-1-box-shadow. Properties are ordered
+// by style name. When a property name is already taken, the style's
+// properties are named after it with a number appended, as in --brand-primary-2.
+func Stylesheet(f *figma.File, opts ...Option) string {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	styles := o.styles
+	if styles == nil {
+		styles = f.StyleValues()
+	}
+	byID := make(map[string]nodes.Node)
+	for _, n := range nodes.All(&f.Document) {
+		byID[n.GetID()] = n
+	}
+	var b strings.Builder
+	b.WriteString(":root {\n")
+	used := make(map[string]bool)
+	for _, v := range styles {
+		ds := styleDeclarations(byID, v)
+		base := propertyName(v.Name)
+		name := base
+		for i := 2; taken(used, name, ds); i++ {
+			name = fmt.Sprintf("%s-%d", base, i)
+		}
+		for _, d := range ds {
+			p := property(name, d)
+			used[p] = true
+			fmt.Fprintf(&b, "  --%s: %s;\n", p, d.Value)
+		}
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// property returns the name of the custom property declaring d for the style named name.
+func property(name string, d Declaration) string {
+	if d.Property == "background" {
+		return name
+	}
+	return name + "-" + d.Property
+}
+
+// taken reports whether any property declaring ds for the style named name is used.
+func taken(used map[string]bool, name string, ds Declarations) bool {
+	for _, d := range ds {
+		if used[property(name, d)] {
+			return true
+		}
+	}
+	return false
+}
+
+// styleDeclarations returns the declarations of a style value. byID holds the
+// nodes of the file by ID.
+func styleDeclarations(byID map[string]nodes.Node, v figma.StyleValue) Declarations {
+	switch v.StyleType {
+	case figmatypes.StyleTypeFILL:
+		return Background(v.Paints, nodeSize(byID[v.NodeID]))
+	case figmatypes.StyleTypeTEXT:
+		if v.Text == nil {
+			return nil
+		}
+		return Font(*v.Text)
+	case figmatypes.StyleTypeEFFECT:
+		return Effects(v.Effects, false)
+	case figmatypes.StyleTypeGRID:
+		var ds Declarations
+		for _, g := range v.LayoutGrids {
+			if !g.IsVisible() {
+				continue
+			}
+			prefix := strings.ToLower(string(g.Pattern))
+			if g.Count > 0 {
+				ds.add(prefix+"-count", fmt.Sprint(g.Count))
+			}
+			if g.SectionSize > 0 {
+				ds.add(prefix+"-section-size", px(g.SectionSize))
+			}
+			ds.add(prefix+"-gutter", px(g.GutterSize))
+			ds.add(prefix+"-offset", px(g.Offset))
+		}
+		return ds
+	}
+	return nil
+}
+
+// nodeSize returns the size of the node a style value was read from, which
+// places the handles of gradients, or 1x1 if the node is not in the file.
+func nodeSize(n nodes.Node) figmatypes.Vector {
+	if b, ok := n.(nodes.Bounded); ok {
+		r := b.GetAbsoluteBoundingBox()
+		return figmatypes.Vector{X: r.Width, Y: r.Height}
+	}
+	return figmatypes.Vector{X: 1, Y: 1}
+}
+
+// propertyName returns name in lower case with every run of other characters
+// than letters and digits replaced by a hyphen.
+func propertyName(name string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(name) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			hyphen = false
+			b.WriteRune(r)
+		} else {
+			hyphen = true
+		}
+	}
+	if b.Len() == 0 {
+		return "style"
+	}
+	return b.String()
+}
@@ -0,0 +1,12 @@
+// Package drift detects JSON fields returned by the Figma API that the Go types in this module do not model.
+//
+// A typical use is a periodic job that strictly decodes a set of files and prints a report:
+//
+//	r := drift.NewReport()
+//	for _, key := range keys {
+//		buf, _ := c.GetFileJSON(key)
+//		res, _ := drift.Decode(buf, &figma.File{})
+//		r.Add(key, res)
+//	}
+//	r.WriteTo(os.Stdout)
+package drift
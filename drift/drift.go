@@ -0,0 +1,130 @@
+package drift
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/tmc/figma/internal/jsonfields"
+	"github.com/tmc/figma/nodes"
+)
+
+var (
+	childrenType = reflect.TypeOf(nodes.Children{})
+	nodeType     = reflect.TypeOf((*nodes.Node)(nil)).Elem()
+	rawType      = reflect.TypeOf(json.RawMessage{})
+)
+
+// Field is a JSON field that is not modeled by the Go type it was decoded into.
+type Field struct {
+	// Owner names the type the field appeared on: a node type such as "FRAME" or a Go type such as "figmatypes.Paint".
+	Owner string
+	// Key is the JSON key of the field.
+	Key string
+	// Path is the JSON path of the object holding the field, such as "document.children[0].fills[1]".
+	Path string
+}
+
+// Result records the drift found while decoding a single document.
+type Result struct {
+	// Fields not modeled by the Go types, depth first with object keys in sorted order.
+	Fields []Field
+	// UnknownNodeTypes lists node types that decoded as nodes.Unknown, with the path of each occurrence.
+	UnknownNodeTypes map[nodes.NodeType][]string
+}
+
+// Decode unmarshals data into v like json.Unmarshal and records every JSON field that v's type does not model.
+func Decode(data []byte, v interface{}) (*Result, error) {
+	if err := json.Unmarshal(data, v); err != nil {
+		return nil, err
+	}
+	var raw interface{}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	r := &Result{UnknownNodeTypes: make(map[nodes.NodeType][]string)}
+	r.walk(raw, reflect.TypeOf(v), "")
+	return r, nil
+}
+
+func (r *Result) walk(raw interface{}, t reflect.Type, path string) {
+	t = jsonfields.Deref(t)
+	if t == rawType {
+		return
+	}
+	switch raw := raw.(type) {
+	case []interface{}:
+		if t == childrenType {
+			for i, e := range raw {
+				r.walkNode(e, join(path, i))
+			}
+			return
+		}
+		if t.Kind() != reflect.Slice && t.Kind() != reflect.Array {
+			return
+		}
+		for i, e := range raw {
+			r.walk(e, t.Elem(), join(path, i))
+		}
+	case map[string]interface{}:
+		switch t.Kind() {
+		case reflect.Map:
+			for _, k := range sortedKeys(raw) {
+				r.walk(raw[k], t.Elem(), join(path, k))
+			}
+		case reflect.Interface:
+			if t == nodeType {
+				r.walkNode(raw, path)
+			}
+		case reflect.Struct:
+			r.walkStruct(raw, t, t.String(), path)
+		}
+	}
+}
+
+func (r *Result) walkNode(raw interface{}, path string) {
+	m, ok := raw.(map[string]interface{})
+	if !ok {
+		return
+	}
+	typ, _ := m["type"].(string)
+	n := nodes.New(nodes.NodeType(typ))
+	if _, unknown := n.(*nodes.Unknown); unknown {
+		r.UnknownNodeTypes[nodes.NodeType(typ)] = append(r.UnknownNodeTypes[nodes.NodeType(typ)], path)
+		r.walk(m["children"], childrenType, join(path, "children"))
+		return
+	}
+	r.walkStruct(m, reflect.TypeOf(n), typ, path)
+}
+
+func (r *Result) walkStruct(raw map[string]interface{}, t reflect.Type, owner, path string) {
+	fields := jsonfields.Of(t)
+	for _, k := range sortedKeys(raw) {
+		f, ok := fields[k]
+		if !ok {
+			r.Fields = append(r.Fields, Field{Owner: owner, Key: k, Path: path})
+			continue
+		}
+		r.walk(raw[k], f.Type, join(path, k))
+	}
+}
+
+func join(path string, elem interface{}) string {
+	if i, ok := elem.(int); ok {
+		return fmt.Sprintf("%s[%d]", path, i)
+	}
+	if path == "" {
+		return fmt.Sprint(elem)
+	}
+	return fmt.Sprintf("%s.%v", path, elem)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
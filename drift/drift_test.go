@@ -0,0 +1,70 @@
+package drift
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/nodes"
+)
+
+const doc = `{
+  "name": "File",
+  "editorType": "figma",
+  "document": {
+    "id": "0:0",
+    "type": "DOCUMENT",
+    "children": [
+      {
+        "id": "1:0",
+        "type": "CANVAS",
+        "prototypeDevice": {"type": "NONE"},
+        "children": [
+          {
+            "id": "1:1",
+            "type": "RECTANGLE",
+            "fills": [{"type": "SOLID", "paintMode": "NORMAL"}]
+          },
+          {"id": "1:2", "type": "HOLOGRAM"}
+        ]
+      }
+    ]
+  }
+}`
+
+func TestDecode(t *testing.T) {
+	f := &figma.File{}
+	res, err := Decode([]byte(doc), f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.Name != "File" {
+		t.Errorf("file not decoded: %+v", f)
+	}
+	want := []Field{
+		{Owner: "figmatypes.Paint", Key: "paintMode", Path: "document.children[0].children[0].fills[0]"},
+		{Owner: "CANVAS", Key: "prototypeDevice", Path: "document.children[0]"},
+		{Owner: "figma.File", Key: "editorType", Path: ""},
+	}
+	if len(res.Fields) != len(want) {
+		t.Fatalf("got %+v, want %+v", res.Fields, want)
+	}
+	for i := range want {
+		if res.Fields[i] != want[i] {
+			t.Errorf("field %d: got %+v, want %+v", i, res.Fields[i], want[i])
+		}
+	}
+	if got := res.UnknownNodeTypes[nodes.NodeType("HOLOGRAM")]; len(got) != 1 || got[0] != "document.children[0].children[1]" {
+		t.Errorf("unknown node types: got %v", res.UnknownNodeTypes)
+	}
+
+	r := NewReport()
+	r.Add("a", res)
+	r.Add("b", res)
+	if o := r.Fields["figmatypes.Paint"]["paintMode"]; o == nil || o.Count != 2 || len(o.Documents) != 2 {
+		t.Errorf("got occurrences %+v", o)
+	}
+	if s := r.String(); !strings.Contains(s, "CANVAS.prototypeDevice") || !strings.Contains(s, "HOLOGRAM") {
+		t.Errorf("report missing entries:\n%s", s)
+	}
+}
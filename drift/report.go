@@ -0,0 +1,130 @@
+package drift
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+	"text/tabwriter"
+
+	"github.com/tmc/figma/nodes"
+)
+
+// Report summarizes drift across many documents.
+type Report struct {
+	// Documents is the number of results added to the report.
+	Documents int
+	// Fields maps an owner and a JSON key to the occurrences of that unmodeled field.
+	Fields map[string]map[string]*Occurrences
+	// UnknownNodeTypes maps node types decoded as nodes.Unknown to their occurrences.
+	UnknownNodeTypes map[nodes.NodeType]*Occurrences
+}
+
+// Occurrences tallies where something was seen.
+type Occurrences struct {
+	// Count is the total number of occurrences.
+	Count int
+	// Documents lists the names of the documents it appeared in.
+	Documents []string
+	// Example is the JSON path of the first occurrence.
+	Example string
+}
+
+func (o *Occurrences) add(doc, path string) {
+	if o.Count == 0 {
+		o.Example = path
+	}
+	o.Count++
+	if n := len(o.Documents); n == 0 || o.Documents[n-1] != doc {
+		o.Documents = append(o.Documents, doc)
+	}
+}
+
+// NewReport initializes an empty Report.
+func NewReport() *Report {
+	return &Report{
+		Fields:           make(map[string]map[string]*Occurrences),
+		UnknownNodeTypes: make(map[nodes.NodeType]*Occurrences),
+	}
+}
+
+// Add records the result of decoding the named document.
+func (r *Report) Add(name string, res *Result) {
+	r.Documents++
+	for _, f := range res.Fields {
+		keys, ok := r.Fields[f.Owner]
+		if !ok {
+			keys = make(map[string]*Occurrences)
+			r.Fields[f.Owner] = keys
+		}
+		o, ok := keys[f.Key]
+		if !ok {
+			o = &Occurrences{}
+			keys[f.Key] = o
+		}
+		o.add(name, f.Path)
+	}
+	for t, paths := range res.UnknownNodeTypes {
+		o, ok := r.UnknownNodeTypes[t]
+		if !ok {
+			o = &Occurrences{}
+			r.UnknownNodeTypes[t] = o
+		}
+		for _, p := range paths {
+			o.add(name, p)
+		}
+	}
+}
+
+// Empty reports whether no drift was found.
+func (r *Report) Empty() bool {
+	return len(r.Fields) == 0 && len(r.UnknownNodeTypes) == 0
+}
+
+// WriteTo writes a human readable summary of the report, sorted by owner and key.
+func (r *Report) WriteTo(w io.Writer) (int64, error) {
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "%d documents checked\n", r.Documents)
+	tw := tabwriter.NewWriter(buf, 0, 4, 2, ' ', 0)
+	if len(r.UnknownNodeTypes) > 0 {
+		fmt.Fprintf(tw, "\nunknown node types\tcount\tdocuments\texample\n")
+		types := make([]string, 0, len(r.UnknownNodeTypes))
+		for t := range r.UnknownNodeTypes {
+			types = append(types, string(t))
+		}
+		sort.Strings(types)
+		for _, t := range types {
+			o := r.UnknownNodeTypes[nodes.NodeType(t)]
+			fmt.Fprintf(tw, "%s\t%d\t%d\t%s\n", t, o.Count, len(o.Documents), o.Example)
+		}
+	}
+	if len(r.Fields) > 0 {
+		fmt.Fprintf(tw, "\nunknown fields\tcount\tdocuments\texample\n")
+		owners := make([]string, 0, len(r.Fields))
+		for owner := range r.Fields {
+			owners = append(owners, owner)
+		}
+		sort.Strings(owners)
+		for _, owner := range owners {
+			keys := make([]string, 0, len(r.Fields[owner]))
+			for k := range r.Fields[owner] {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				o := r.Fields[owner][k]
+				fmt.Fprintf(tw, "%s.%s\t%d\t%d\t%s\n", owner, k, o.Count, len(o.Documents), o.Example)
+			}
+		}
+	}
+	tw.Flush()
+	n, err := w.Write(buf.Bytes())
+	return int64(n), err
+}
+
+// String returns the summary written by WriteTo.
+func (r *Report) String() string {
+	buf := new(bytes.Buffer)
+	r.WriteTo(buf)
+	return buf.String()
+}
@@ -0,0 +1,53 @@
+package figma
+
+// EventType is the type of an event, as delivered by Figma webhooks or produced by polling.
+type EventType string
+
+const (
+	// EventTypePING is sent when a webhook is created.
+	EventTypePING EventType = "PING"
+	// EventTypeFILE_UPDATE is sent when a file is edited.
+	EventTypeFILE_UPDATE EventType = "FILE_UPDATE"
+	// EventTypeFILE_VERSION_UPDATE is sent when a named version is created in a file's history.
+	EventTypeFILE_VERSION_UPDATE EventType = "FILE_VERSION_UPDATE"
+	// EventTypeFILE_DELETE is sent when a file is deleted.
+	EventTypeFILE_DELETE EventType = "FILE_DELETE"
+	// EventTypeLIBRARY_PUBLISH is sent when a library file is published.
+	EventTypeLIBRARY_PUBLISH EventType = "LIBRARY_PUBLISH"
+	// EventTypeFILE_COMMENT is sent when a comment is added to a file.
+	EventTypeFILE_COMMENT EventType = "FILE_COMMENT"
+	// EventTypeFILE_COMMENT_RESOLVED is sent when a comment is resolved. Webhooks do not deliver this type.
+	EventTypeFILE_COMMENT_RESOLVED EventType = "FILE_COMMENT_RESOLVED"
+)
+
+// Event describes a change to a file. Its JSON encoding matches Figma webhook payloads.
+type Event struct {
+	// The type of the event.
+	Type EventType `json:"event_type"`
+	// The key of the file that changed.
+	FileKey string `json:"file_key,omitempty"`
+	// The name of the file that changed.
+	FileName string `json:"file_name,omitempty"`
+	// The UTC ISO 8601 time at which the event was triggered.
+	Timestamp string `json:"timestamp,omitempty"`
+	// The user that triggered the event, if known.
+	TriggeredBy *User `json:"triggered_by,omitempty"`
+	// The id of the version created. Set for FILE_VERSION_UPDATE.
+	VersionID string `json:"version_id,omitempty"`
+	// The label of the version created. Set for FILE_VERSION_UPDATE.
+	Label string `json:"label,omitempty"`
+	// The description of the version created. Set for FILE_VERSION_UPDATE.
+	Description string `json:"description,omitempty"`
+	// The id of the comment. Set for FILE_COMMENT and FILE_COMMENT_RESOLVED.
+	CommentID string `json:"comment_id,omitempty"`
+	// The contents of the comment. Set for FILE_COMMENT and FILE_COMMENT_RESOLVED.
+	Comment []CommentFragment `json:"comment,omitempty"`
+}
+
+// CommentFragment is a piece of a comment as delivered in events.
+type CommentFragment struct {
+	// Comment text.
+	Text string `json:"text,omitempty"`
+	// Set if the fragment mentions a user.
+	Mention string `json:"mention,omitempty"`
+}
@@ -0,0 +1,383 @@
+package figmatest
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// Builder builds a file and, optionally, its local variables. Errors made while
+// building are reported by File.
+type Builder struct {
+	file     *figma.File
+	doc      *Node
+	nextID   int
+	nextPage int
+	vars     *figma.LocalVariables
+	errs     []error
+}
+
+// New returns a builder for a file with the given name and an empty document.
+func New(name string) *Builder {
+	b := &Builder{
+		file: &figma.File{
+			Name:       name,
+			Styles:     make(map[string]figmatypes.Style),
+			Components: make(map[string]figma.ComponentReference),
+		},
+	}
+	b.file.Document.ID = "0:0"
+	b.file.Document.Name = "Document"
+	b.file.Document.Type = nodes.NodeTypeDOCUMENT
+	b.doc = &Node{b: b, n: &b.file.Document}
+	return b
+}
+
+func (b *Builder) id() string {
+	b.nextID++
+	return fmt.Sprintf("1:%d", b.nextID)
+}
+
+func (b *Builder) errorf(format string, args ...interface{}) {
+	b.errs = append(b.errs, fmt.Errorf("figmatest: "+format, args...))
+}
+
+// Canvas adds a page to the document.
+func (b *Builder) Canvas(name string) *Node {
+	b.nextPage++
+	c := &nodes.Canvas{}
+	c.ID = fmt.Sprintf("0:%d", b.nextPage)
+	c.Name = name
+	c.Type = nodes.NodeTypeCANVAS
+	b.file.Document.Children = append(b.file.Document.Children, c)
+	return &Node{b: b, n: c}
+}
+
+// Style registers a style and returns its ID, for use with Node.Style.
+func (b *Builder) Style(name string, t figmatypes.StyleType) string {
+	id := fmt.Sprintf("S:%d", len(b.file.Styles)+1)
+	b.file.Styles[id] = figmatypes.Style{Key: fmt.Sprintf("style%d", len(b.file.Styles)+1), Name: name, StyleType: t}
+	return id
+}
+
+// Describe sets the description of a style registered with Style.
+func (b *Builder) Describe(styleID, description string) {
+	s, ok := b.file.Styles[styleID]
+	if !ok {
+		b.errorf("cannot describe unknown style %s", styleID)
+		return
+	}
+	s.Description = description
+	b.file.Styles[styleID] = s
+}
+
+// File validates the file and returns it. Validation fails if a builder method was misused,
+// or if nodes changed through Node break the file's invariants: node IDs must be unique,
+// instances must refer to components in the file's components, and style references must
+// refer to the file's styles. The aliases between variables are checked too.
+func (b *Builder) File() (*figma.File, error) {
+	errs := b.errs
+	seen := make(map[string]bool)
+	for _, n := range nodes.All(&b.file.Document) {
+		id := n.GetID()
+		if seen[id] {
+			errs = append(errs, fmt.Errorf("figmatest: duplicate node ID %s", id))
+		}
+		seen[id] = true
+		if i, ok := n.(*nodes.Instance); ok {
+			if _, ok := b.file.Components[i.ComponentID]; !ok {
+				errs = append(errs, fmt.Errorf("figmatest: instance %s refers to unknown component %q", id, i.ComponentID))
+			}
+		}
+		if s, ok := n.(nodes.Styled); ok {
+			refs := s.GetStyles()
+			keys := make([]string, 0, len(refs))
+			for k := range refs {
+				keys = append(keys, k)
+			}
+			sort.Strings(keys)
+			for _, k := range keys {
+				if _, ok := b.file.Styles[refs[k]]; !ok {
+					errs = append(errs, fmt.Errorf("figmatest: node %s refers to unknown %s style %q", id, k, refs[k]))
+				}
+			}
+		}
+	}
+	errs = append(errs, b.checkAliases()...)
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+	return b.file, nil
+}
+
+// MustFile is like File but fails the test if the file is invalid.
+func (b *Builder) MustFile(t testing.TB) *figma.File {
+	t.Helper()
+	f, err := b.File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return f
+}
+
+// JSON returns the file encoded as indented JSON, as the API would return it.
+func (b *Builder) JSON() ([]byte, error) {
+	f, err := b.File()
+	if err != nil {
+		return nil, err
+	}
+	return json.MarshalIndent(f, "", "  ")
+}
+
+// WriteFixture writes the file as JSON to path.
+func (b *Builder) WriteFixture(path string) error {
+	data, err := b.JSON()
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// Node is a node being built.
+type Node struct {
+	b *Builder
+	n nodes.Node
+	// The absolute bounding box; zero for documents and canvases.
+	abs figmatypes.Rectangle
+}
+
+// Node returns the built node.
+func (n *Node) Node() nodes.Node {
+	return n.n
+}
+
+// ID returns the ID of the node.
+func (n *Node) ID() string {
+	return n.n.GetID()
+}
+
+// children returns the children of containers the builder can add to.
+func (n *Node) children() *nodes.Children {
+	switch v := n.n.(type) {
+	case *nodes.Document:
+		return &v.Children
+	case *nodes.Canvas:
+		return &v.Children
+	case *nodes.Frame:
+		return &v.Children
+	case *nodes.Component:
+		return &v.Children
+	}
+	return nil
+}
+
+// add appends a child positioned at (x, y) relative to n.
+func (n *Node) add(child nodes.Node, base *nodes.NodeBase, name string, t nodes.NodeType, x, y, w, h float64) *Node {
+	base.ID = n.b.id()
+	base.Name = name
+	base.Type = t
+	c := &Node{b: n.b, n: child, abs: figmatypes.Rectangle{X: n.abs.X + x, Y: n.abs.Y + y, Width: w, Height: h}}
+	switch v := child.(type) {
+	case *nodes.Frame:
+		setFrameGeometry(v, c.abs, x, y)
+	case *nodes.Component:
+		setFrameGeometry(&v.Frame, c.abs, x, y)
+	case *nodes.Instance:
+		setFrameGeometry(&v.Frame, c.abs, x, y)
+	case *nodes.Rectangle:
+		setVectorGeometry(&v.Vector, c.abs, x, y)
+	case *nodes.Text:
+		setVectorGeometry(&v.Vector, c.abs, x, y)
+	}
+	children := n.children()
+	if children == nil {
+		n.b.errorf("cannot add %s %q to %s %s", t, name, n.n.GetType(), n.ID())
+		return c
+	}
+	*children = append(*children, child)
+	return c
+}
+
+func setFrameGeometry(f *nodes.Frame, abs figmatypes.Rectangle, x, y float64) {
+	f.AbsoluteBoundingBox = abs
+	f.Size = &figmatypes.Vector{X: abs.Width, Y: abs.Height}
+	t := figmatypes.TranslateTransform(x, y)
+	f.RelativeTransform = &t
+}
+
+func setVectorGeometry(v *nodes.Vector, abs figmatypes.Rectangle, x, y float64) {
+	v.AbsoluteBoundingBox = abs
+	v.Size = &figmatypes.Vector{X: abs.Width, Y: abs.Height}
+	t := figmatypes.TranslateTransform(x, y)
+	v.RelativeTransform = &t
+}
+
+// Frame adds a frame at (x, y) relative to n.
+func (n *Node) Frame(name string, x, y, w, h float64) *Node {
+	f := &nodes.Frame{}
+	return n.add(f, &f.NodeBase, name, nodes.NodeTypeFRAME, x, y, w, h)
+}
+
+// Component adds a component at (x, y) relative to n and registers it in the file's components.
+func (n *Node) Component(name string, x, y, w, h float64) *Node {
+	c := &nodes.Component{}
+	result := n.add(c, &c.NodeBase, name, nodes.NodeTypeCOMPONENT, x, y, w, h)
+	n.b.file.Components[c.ID] = figma.ComponentReference{Name: name}
+	return result
+}
+
+// Instance adds an instance of component at (x, y) relative to n, with the component's name and size.
+func (n *Node) Instance(component *Node, x, y float64) *Node {
+	c, ok := component.n.(*nodes.Component)
+	if !ok || component.b != n.b {
+		n.b.errorf("instance of %s %s, which is not a component of this file", component.n.GetType(), component.ID())
+		return &Node{b: n.b, n: &nodes.Instance{}}
+	}
+	i := &nodes.Instance{ComponentID: c.ID}
+	return n.add(i, &i.NodeBase, c.Name, nodes.NodeTypeINSTANCE, x, y, component.abs.Width, component.abs.Height)
+}
+
+// Rectangle adds a rectangle at (x, y) relative to n.
+func (n *Node) Rectangle(name string, x, y, w, h float64) *Node {
+	r := &nodes.Rectangle{}
+	return n.add(r, &r.NodeBase, name, nodes.NodeTypeRECTANGLE, x, y, w, h)
+}
+
+// Text adds a text box containing characters at (x, y) relative to n.
+func (n *Node) Text(name, characters string, x, y, w, h float64) *Node {
+	t := &nodes.Text{Characters: characters}
+	return n.add(t, &t.NodeBase, name, nodes.NodeTypeTEXT, x, y, w, h)
+}
+
+// Hidden marks the node as not visible.
+func (n *Node) Hidden() *Node {
+	visible := false
+	switch v := n.n.(type) {
+	case *nodes.Frame:
+		v.Visible = &visible
+	case *nodes.Component:
+		v.Visible = &visible
+	case *nodes.Instance:
+		v.Visible = &visible
+	case *nodes.Rectangle:
+		v.Visible = &visible
+	case *nodes.Text:
+		v.Visible = &visible
+	default:
+		n.b.errorf("cannot hide %s %s", n.n.GetType(), n.ID())
+	}
+	return n
+}
+
+// vector returns the vector fields of shapes and text.
+func (n *Node) vector() *nodes.Vector {
+	switch v := n.n.(type) {
+	case *nodes.Rectangle:
+		return &v.Vector
+	case *nodes.Text:
+		return &v.Vector
+	}
+	return nil
+}
+
+// frame returns the frame fields of frames, components and instances.
+func (n *Node) frame() *nodes.Frame {
+	switch v := n.n.(type) {
+	case *nodes.Frame:
+		return v
+	case *nodes.Component:
+		return &v.Frame
+	case *nodes.Instance:
+		return &v.Frame
+	}
+	return nil
+}
+
+// Paint adds a paint to the fills of the node.
+func (n *Node) Paint(p figmatypes.Paint) *Node {
+	if v := n.vector(); v != nil {
+		v.Fills = append(v.Fills, p)
+	} else if f := n.frame(); f != nil {
+		f.Fills = append(f.Fills, p)
+	} else {
+		n.b.errorf("cannot paint %s %s", n.n.GetType(), n.ID())
+	}
+	return n
+}
+
+// Effect adds an effect to the node.
+func (n *Node) Effect(e figmatypes.Effect) *Node {
+	if v := n.vector(); v != nil {
+		v.Effects = append(v.Effects, e)
+	} else if f := n.frame(); f != nil {
+		f.Effects = append(f.Effects, e)
+	} else {
+		n.b.errorf("cannot add an effect to %s %s", n.n.GetType(), n.ID())
+	}
+	return n
+}
+
+// LayoutGrid adds a layout grid to a frame, component or instance.
+func (n *Node) LayoutGrid(g figmatypes.LayoutGrid) *Node {
+	if f := n.frame(); f != nil {
+		f.LayoutGrids = append(f.LayoutGrids, g)
+	} else {
+		n.b.errorf("cannot add a layout grid to %s %s", n.n.GetType(), n.ID())
+	}
+	return n
+}
+
+// TextStyle sets the type style of a text node.
+func (n *Node) TextStyle(s figmatypes.TypeStyle) *Node {
+	if t, ok := n.n.(*nodes.Text); ok {
+		t.Style = s
+	} else {
+		n.b.errorf("cannot set the type style of %s %s", n.n.GetType(), n.ID())
+	}
+	return n
+}
+
+// Fill adds a solid fill to the node. Frames also get it as their background color.
+func (n *Node) Fill(c figmatypes.Color) *Node {
+	if v := n.vector(); v != nil {
+		v.Fills = append(v.Fills, figmatypes.Paint{Type: figmatypes.PaintTypeSOLID, Color: &c})
+	} else if f := n.frame(); f != nil {
+		f.Fills = append(f.Fills, figmatypes.Paint{Type: figmatypes.PaintTypeSOLID, Color: &c})
+		f.BackgroundColor = c
+	} else {
+		n.b.errorf("cannot fill %s %s", n.n.GetType(), n.ID())
+	}
+	return n
+}
+
+// Style applies a style registered with Builder.Style to the node.
+func (n *Node) Style(id string) *Node {
+	s, ok := n.b.file.Styles[id]
+	if !ok {
+		n.b.errorf("unknown style %s applied to %s", id, n.ID())
+		return n
+	}
+	var styles *map[string]string
+	if v := n.vector(); v != nil {
+		styles = &v.Styles
+	} else if f := n.frame(); f != nil {
+		styles = &f.Styles
+	} else {
+		n.b.errorf("cannot apply style %s to %s %s", id, n.n.GetType(), n.ID())
+		return n
+	}
+	if *styles == nil {
+		*styles = make(map[string]string)
+	}
+	// Nodes key their styles by the lower-case style type.
+	(*styles)[strings.ToLower(string(s.StyleType))] = id
+	return n
+}
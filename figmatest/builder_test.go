@@ -0,0 +1,105 @@
+package figmatest
+
+import (
+	"encoding/json"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/index"
+	"github.com/tmc/figma/nodes"
+)
+
+func TestBuilder(t *testing.T) {
+	b := New("Fixture")
+	primary := b.Style("Primary", figmatypes.StyleTypeTEXT)
+	page := b.Canvas("Page 1")
+	button := page.Component("Button", 0, 0, 120, 40).Fill(figmatypes.Color{R: 1, A: 1})
+	label := button.Text("Label", "OK", 8, 8, 104, 24).Style(primary)
+	card := page.Frame("Card", 200, 100, 320, 200)
+	inst := card.Instance(button, 16, 140)
+	card.Rectangle("Divider", 0, 120, 320, 1).Hidden()
+	f := b.MustFile(t)
+
+	x := index.New(f)
+	if got := x.Instances(button.ID()); len(got) != 1 || got[0].GetID() != inst.ID() {
+		t.Errorf("Instances(%s) = %v", button.ID(), got)
+	}
+	if got := x.StyleUsers(primary); len(got) != 1 || got[0].GetID() != label.ID() {
+		t.Errorf("StyleUsers(%s) = %v", primary, got)
+	}
+	if ref, ok := f.Components[button.ID()]; !ok || ref.Name != "Button" {
+		t.Errorf("Components[%s] = %+v, %v", button.ID(), ref, ok)
+	}
+	want := figmatypes.Rectangle{X: 216, Y: 240, Width: 120, Height: 40}
+	if got := inst.Node().(*nodes.Instance).AbsoluteBoundingBox; got != want {
+		t.Errorf("instance bounds = %+v, want %+v", got, want)
+	}
+
+	colors := b.Collection("Colors", "Light", "Dark")
+	blue := colors.Variable("blue", figmatypes.VariableResolvedTypeCOLOR, figmatypes.Color{B: 1, A: 1}, figmatypes.Color{B: 0.5, A: 1})
+	accent := colors.Variable("accent", figmatypes.VariableResolvedTypeCOLOR, blue.Alias(), blue.Alias()).Scopes("ALL_FILLS")
+	lv := b.Variables()
+	if c := lv.VariableCollections[colors.ID()]; len(c.Modes) != 2 || c.DefaultModeID != c.Modes[0].ModeID || len(c.VariableIDs) != 2 {
+		t.Errorf("collection = %+v", c)
+	}
+	if v := lv.Variables[accent.ID()]; v.ValuesByMode[lv.VariableCollections[colors.ID()].Modes[1].ModeID].Alias.ID != blue.ID() || len(v.Scopes) != 1 {
+		t.Errorf("accent = %+v", v)
+	}
+
+	path := filepath.Join(t.TempDir(), "fixture.json")
+	if err := b.WriteFixture(path); err != nil {
+		t.Fatal(err)
+	}
+	data, err := b.JSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded figma.File
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if n, ok := index.New(&decoded).Node(label.ID()); !ok || n.(*nodes.Text).Characters != "OK" {
+		t.Errorf("decoded fixture is missing %s", label.ID())
+	}
+}
+
+func TestBuilderErrors(t *testing.T) {
+	b := New("Invalid")
+	page := b.Canvas("Page 1")
+	text := page.Text("Label", "Hi", 0, 0, 10, 10)
+	text.Rectangle("Child", 0, 0, 1, 1)
+	text.Style("S:99")
+	page.Instance(text, 0, 0)
+
+	// Invariants broken by changing built nodes directly.
+	button := page.Component("Button", 0, 0, 10, 10)
+	inst := page.Instance(button, 20, 0)
+	inst.Node().(*nodes.Instance).ComponentID = "9:9"
+	rect := page.Rectangle("Box", 0, 20, 10, 10)
+	rect.Node().(*nodes.Rectangle).Styles = map[string]string{"fill": "S:42"}
+	page.Rectangle("Copy", 0, 40, 10, 10).Node().(*nodes.Rectangle).ID = rect.ID()
+
+	vars := b.Collection("Primitives")
+	vars.Variable("gap", figmatypes.VariableResolvedTypeFLOAT, figmatypes.VariableAlias{Type: figmatypes.VariableAliasType, ID: "V:99"})
+	vars.Variable("radius", figmatypes.VariableResolvedTypeFLOAT, 4, 8)
+
+	_, err := b.File()
+	if err == nil {
+		t.Fatal("File() succeeded")
+	}
+	for _, want := range []string{
+		"cannot add RECTANGLE", "unknown style S:99", "not a component",
+		"instance " + inst.ID() + ` refers to unknown component "9:9"`,
+		"node " + rect.ID() + ` refers to unknown fill style "S:42"`,
+		"duplicate node ID " + rect.ID(),
+		`aliases unknown variable "V:99"`,
+		"has 2 values for the 1 modes",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error %q does not mention %q", err, want)
+		}
+	}
+}
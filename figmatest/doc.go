@@ -0,0 +1,19 @@
+// Package figmatest builds Figma files for tests and fixtures.
+//
+// A Builder assigns node IDs, positions children relative to their parent,
+// computes absolute bounding boxes and registers components and styles in
+// the file:
+//
+//	b := figmatest.New("Fixture")
+//	page := b.Canvas("Page 1")
+//	button := page.Component("Button", 0, 0, 120, 40)
+//	button.Text("Label", "OK", 8, 8, 104, 24)
+//	card := page.Frame("Card", 200, 0, 320, 200)
+//	card.Instance(button, 16, 140)
+//	f, err := b.File()
+//
+// File validates the result: node IDs are unique, instances refer to
+// registered components and style references to registered styles. Nodes
+// can be changed through Node.Node before calling File, and the same checks
+// apply to the changes. Collection adds variables, returned by Variables.
+package figmatest
@@ -0,0 +1,149 @@
+package figmatest
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Collection is a variable collection being built.
+type Collection struct {
+	b  *Builder
+	id string
+}
+
+// Variable is a variable being built.
+type Variable struct {
+	b  *Builder
+	id string
+}
+
+// Collection adds a variable collection with the named modes. The first mode is the default;
+// a collection without modes gets one named "Value".
+func (b *Builder) Collection(name string, modes ...string) *Collection {
+	if b.vars == nil {
+		b.vars = &figma.LocalVariables{
+			Variables:           make(map[string]figmatypes.Variable),
+			VariableCollections: make(map[string]figmatypes.VariableCollection),
+		}
+	}
+	if len(modes) == 0 {
+		modes = []string{"Value"}
+	}
+	n := len(b.vars.VariableCollections) + 1
+	c := figmatypes.VariableCollection{ID: fmt.Sprintf("C:%d", n), Name: name}
+	for i, m := range modes {
+		c.Modes = append(c.Modes, figmatypes.VariableMode{ModeID: fmt.Sprintf("%d:%d", n, i), Name: m})
+	}
+	c.DefaultModeID = c.Modes[0].ModeID
+	b.vars.VariableCollections[c.ID] = c
+	return &Collection{b: b, id: c.ID}
+}
+
+// ID returns the ID of the collection.
+func (c *Collection) ID() string {
+	return c.id
+}
+
+// Variable adds a variable to the collection with a value for each of its modes, in order.
+// Values are bools, float64s, strings, figmatypes.Colors, or aliases returned by Variable.Alias.
+func (c *Collection) Variable(name string, t figmatypes.VariableResolvedType, values ...interface{}) *Variable {
+	coll := c.b.vars.VariableCollections[c.id]
+	v := figmatypes.Variable{
+		ID:                   fmt.Sprintf("V:%d", len(c.b.vars.Variables)+1),
+		Name:                 name,
+		VariableCollectionID: c.id,
+		ResolvedType:         t,
+		ValuesByMode:         make(map[string]figmatypes.VariableValue),
+	}
+	if len(values) != len(coll.Modes) {
+		c.b.errorf("variable %q has %d values for the %d modes of %q", name, len(values), len(coll.Modes), coll.Name)
+	}
+	for i, value := range values {
+		if i >= len(coll.Modes) {
+			break
+		}
+		var vv figmatypes.VariableValue
+		switch x := value.(type) {
+		case bool:
+			vv.Bool = &x
+		case float64:
+			vv.Float = &x
+		case int:
+			f := float64(x)
+			vv.Float = &f
+		case string:
+			vv.String = &x
+		case figmatypes.Color:
+			vv.Color = &x
+		case figmatypes.VariableAlias:
+			vv.Alias = &x
+		default:
+			c.b.errorf("variable %q has a value of unsupported type %T", name, value)
+			continue
+		}
+		v.ValuesByMode[coll.Modes[i].ModeID] = vv
+	}
+	coll.VariableIDs = append(coll.VariableIDs, v.ID)
+	c.b.vars.VariableCollections[c.id] = coll
+	c.b.vars.Variables[v.ID] = v
+	return &Variable{b: c.b, id: v.ID}
+}
+
+// ID returns the ID of the variable.
+func (v *Variable) ID() string {
+	return v.id
+}
+
+// Alias returns an alias to the variable, for use as the value of another variable
+// or in the bound variables of a paint.
+func (v *Variable) Alias() figmatypes.VariableAlias {
+	return figmatypes.VariableAlias{Type: figmatypes.VariableAliasType, ID: v.id}
+}
+
+// Scopes sets the fields the variable may be bound to, such as "GAP" or "CORNER_RADIUS".
+func (v *Variable) Scopes(scopes ...string) *Variable {
+	x := v.b.vars.Variables[v.id]
+	x.Scopes = scopes
+	v.b.vars.Variables[v.id] = x
+	return v
+}
+
+// Variables returns the variables built with Collection, or nil if there are none.
+// Like the file, they are validated by File.
+func (b *Builder) Variables() *figma.LocalVariables {
+	return b.vars
+}
+
+// checkAliases reports variable values that alias variables that were not built.
+func (b *Builder) checkAliases() []error {
+	if b.vars == nil {
+		return nil
+	}
+	var errs []error
+	ids := make([]string, 0, len(b.vars.Variables))
+	for id := range b.vars.Variables {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		v := b.vars.Variables[id]
+		modes := make([]string, 0, len(v.ValuesByMode))
+		for m := range v.ValuesByMode {
+			modes = append(modes, m)
+		}
+		sort.Strings(modes)
+		for _, m := range modes {
+			a := v.ValuesByMode[m].Alias
+			if a == nil {
+				continue
+			}
+			if _, ok := b.vars.Variables[a.ID]; !ok {
+				errs = append(errs, fmt.Errorf("figmatest: variable %q aliases unknown variable %q", v.Name, a.ID))
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,147 @@
+package figmatypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/tmc/figma/internal/jsonfields"
+)
+
+//go:generate go run ../internal/cmd/enumgen
+
+// Enum is implemented by the string enum types of this package and of package nodes.
+type Enum interface {
+	String() string
+	// IsValid reports whether the value is known to the package.
+	IsValid() bool
+}
+
+// UnknownEnumError is returned by Decode in strict mode, and by CheckEnums, for an
+// enum value unknown to this package.
+type UnknownEnumError struct {
+	// The name of the enum type.
+	Type  string
+	Value string
+	// The JSON path of the value, such as "document.children[0].blendMode".
+	Path string
+}
+
+func (e *UnknownEnumError) Error() string {
+	if e.Path == "" {
+		return fmt.Sprintf("figmatypes: unknown %s %q", e.Type, e.Value)
+	}
+	return fmt.Sprintf("figmatypes: unknown %s %q at %s", e.Type, e.Value, e.Path)
+}
+
+// DecodeOption configures Decode.
+type DecodeOption func(*decodeOptions)
+
+type decodeOptions struct {
+	strict bool
+}
+
+// Strict makes Decode reject enum values unknown to this package and package nodes,
+// including the types of nodes decoded as nodes.Unknown.
+func Strict() DecodeOption {
+	return func(o *decodeOptions) { o.strict = true }
+}
+
+// Decode unmarshals data into v like json.Unmarshal. By default enum values unknown
+// to this package are preserved, so that values added to the API after this package
+// was written survive a round trip; with Strict, Decode instead returns an
+// *UnknownEnumError for the first one, as CheckEnums does.
+func Decode(data []byte, v interface{}, opts ...DecodeOption) error {
+	var o decodeOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		return err
+	}
+	if o.strict {
+		return CheckEnums(v)
+	}
+	return nil
+}
+
+var enumType = reflect.TypeOf((*Enum)(nil)).Elem()
+
+// CheckEnums returns an *UnknownEnumError for the first non-empty enum value in v,
+// in JSON field order, that is not known to its package, or nil if there is none.
+// It can be used to validate values built in Go before encoding them.
+func CheckEnums(v interface{}) error {
+	return checkEnums(reflect.ValueOf(v), "")
+}
+
+func checkEnums(v reflect.Value, path string) error {
+	if !v.IsValid() {
+		return nil
+	}
+	if v.Kind() == reflect.String && v.Type().Implements(enumType) {
+		if e := v.Interface().(Enum); v.Len() > 0 && !e.IsValid() {
+			return &UnknownEnumError{Type: v.Type().Name(), Value: e.String(), Path: path}
+		}
+		return nil
+	}
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return checkEnums(v.Elem(), path)
+	case reflect.Struct:
+		fields := jsonfields.Of(v.Type())
+		names := make([]string, 0, len(fields))
+		for name := range fields {
+			names = append(names, name)
+		}
+		sort.Slice(names, func(i, j int) bool { return lessIndex(fields[names[i]].Index, fields[names[j]].Index) })
+		for _, name := range names {
+			f, err := v.FieldByIndexErr(fields[name].Index)
+			if err != nil {
+				// a nil embedded pointer.
+				continue
+			}
+			if err := checkEnums(f, joinPath(path, name)); err != nil {
+				return err
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			if err := checkEnums(v.Index(i), path+"["+strconv.Itoa(i)+"]"); err != nil {
+				return err
+			}
+		}
+	case reflect.Map:
+		keys := v.MapKeys()
+		sort.Slice(keys, func(i, j int) bool { return fmt.Sprint(keys[i]) < fmt.Sprint(keys[j]) })
+		for _, k := range keys {
+			if err := checkEnums(v.MapIndex(k), joinPath(path, fmt.Sprint(k))); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func lessIndex(a, b []int) bool {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			return a[i] < b[i]
+		}
+	}
+	return len(a) < len(b)
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,42 @@
+package figmatypes
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func TestEnums(t *testing.T) {
+	for _, v := range BlendModeValues() {
+		if !v.IsValid() {
+			t.Errorf("%s is not valid", v)
+		}
+	}
+	if BlendMode("FUTURE").IsValid() {
+		t.Error("unknown blend mode is valid")
+	}
+
+	var p Paint
+	in := []byte(`{"type": "PATTERN", "blendMode": "MULTIPLY"}`)
+	if err := json.Unmarshal(in, &p); err != nil || p.Type != "PATTERN" || p.BlendMode != BlendModeMULTIPLY {
+		t.Fatalf("preserving decode: %+v, %v", p, err)
+	}
+	if out, err := json.Marshal(p); err != nil || !json.Valid(out) {
+		t.Fatalf("preserving encode: %s, %v", out, err)
+	}
+
+	var enumErr *UnknownEnumError
+	if err := Decode(in, &p, Strict()); !errors.As(err, &enumErr) || enumErr.Type != "PaintType" || enumErr.Value != "PATTERN" || enumErr.Path != "type" {
+		t.Errorf("strict decode: got %v", err)
+	}
+	if err := Decode(in, &p); err != nil {
+		t.Errorf("decode without Strict: %v", err)
+	}
+	if err := CheckEnums([]Effect{{}, {Type: EffectTypeDROP_SHADOW, BlendMode: "FUTURE"}}); !errors.As(err, &enumErr) || enumErr.Path != "[1].blendMode" {
+		t.Errorf("check: got %v", err)
+	}
+	// Empty values are unset, not unknown.
+	if err := CheckEnums(Effect{}); err != nil {
+		t.Errorf("check of empty values: %v", err)
+	}
+}
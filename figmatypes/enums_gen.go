@@ -0,0 +1,1638 @@
+// Code generated by enumgen. DO NOT EDIT.
+
+package figmatypes
+
+// String returns the value as a string.
+func (v FormatType) String() string { return string(v) }
+
+// IsValid reports whether v is a known FormatType.
+func (v FormatType) IsValid() bool {
+	switch v {
+	case FormatTypeJPG,
+		FormatTypePNG,
+		FormatTypeSVG,
+		FormatTypePDF:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v FormatType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *FormatType) UnmarshalText(text []byte) error {
+	*v = FormatType(text)
+	return nil
+}
+
+// FormatTypeValues returns the known FormatType values.
+func FormatTypeValues() []FormatType {
+	return []FormatType{
+		FormatTypeJPG,
+		FormatTypePNG,
+		FormatTypeSVG,
+		FormatTypePDF,
+	}
+}
+
+// String returns the value as a string.
+func (v ConstraintType) String() string { return string(v) }
+
+// IsValid reports whether v is a known ConstraintType.
+func (v ConstraintType) IsValid() bool {
+	switch v {
+	case ConstraintTypeSCALE,
+		ConstraintTypeWIDTH,
+		ConstraintTypeHEIGHT:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ConstraintType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ConstraintType) UnmarshalText(text []byte) error {
+	*v = ConstraintType(text)
+	return nil
+}
+
+// ConstraintTypeValues returns the known ConstraintType values.
+func ConstraintTypeValues() []ConstraintType {
+	return []ConstraintType{
+		ConstraintTypeSCALE,
+		ConstraintTypeWIDTH,
+		ConstraintTypeHEIGHT,
+	}
+}
+
+// String returns the value as a string.
+func (v BlendMode) String() string { return string(v) }
+
+// IsValid reports whether v is a known BlendMode.
+func (v BlendMode) IsValid() bool {
+	switch v {
+	case BlendModePASS_THROUGH,
+		BlendModeNORMAL,
+		BlendModeDARKEN,
+		BlendModeMULTIPLY,
+		BlendModeLINEAR_BURN,
+		BlendModeCOLOR_BURN,
+		BlendModeLIGHTEN,
+		BlendModeSCREEN,
+		BlendModeLINEAR_DODGE,
+		BlendModeCOLOR_DODGE,
+		BlendModeOVERLAY,
+		BlendModeSOFT_LIGHT,
+		BlendModeHARD_LIGHT,
+		BlendModeDIFFERENCE,
+		BlendModeEXCLUSION,
+		BlendModeHUE,
+		BlendModeSATURATION,
+		BlendModeCOLOR,
+		BlendModeLUMINOSITY:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v BlendMode) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *BlendMode) UnmarshalText(text []byte) error {
+	*v = BlendMode(text)
+	return nil
+}
+
+// BlendModeValues returns the known BlendMode values.
+func BlendModeValues() []BlendMode {
+	return []BlendMode{
+		BlendModePASS_THROUGH,
+		BlendModeNORMAL,
+		BlendModeDARKEN,
+		BlendModeMULTIPLY,
+		BlendModeLINEAR_BURN,
+		BlendModeCOLOR_BURN,
+		BlendModeLIGHTEN,
+		BlendModeSCREEN,
+		BlendModeLINEAR_DODGE,
+		BlendModeCOLOR_DODGE,
+		BlendModeOVERLAY,
+		BlendModeSOFT_LIGHT,
+		BlendModeHARD_LIGHT,
+		BlendModeDIFFERENCE,
+		BlendModeEXCLUSION,
+		BlendModeHUE,
+		BlendModeSATURATION,
+		BlendModeCOLOR,
+		BlendModeLUMINOSITY,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutConstraintVertical) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutConstraintVertical.
+func (v LayoutConstraintVertical) IsValid() bool {
+	switch v {
+	case LayoutConstraintVerticalTOP,
+		LayoutConstraintVerticalBOTTOM,
+		LayoutConstraintVerticalCENTER,
+		LayoutConstraintVerticalTOP_BOTTOM,
+		LayoutConstraintVerticalSCALE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutConstraintVertical) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutConstraintVertical) UnmarshalText(text []byte) error {
+	*v = LayoutConstraintVertical(text)
+	return nil
+}
+
+// LayoutConstraintVerticalValues returns the known LayoutConstraintVertical values.
+func LayoutConstraintVerticalValues() []LayoutConstraintVertical {
+	return []LayoutConstraintVertical{
+		LayoutConstraintVerticalTOP,
+		LayoutConstraintVerticalBOTTOM,
+		LayoutConstraintVerticalCENTER,
+		LayoutConstraintVerticalTOP_BOTTOM,
+		LayoutConstraintVerticalSCALE,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutConstraintHorizontal) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutConstraintHorizontal.
+func (v LayoutConstraintHorizontal) IsValid() bool {
+	switch v {
+	case LayoutConstraintHorizontalLEFT,
+		LayoutConstraintHorizontalRIGHT,
+		LayoutConstraintHorizontalCENTER,
+		LayoutConstraintHorizontalLEFT_RIGHT,
+		LayoutConstraintHorizontalSCALE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutConstraintHorizontal) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutConstraintHorizontal) UnmarshalText(text []byte) error {
+	*v = LayoutConstraintHorizontal(text)
+	return nil
+}
+
+// LayoutConstraintHorizontalValues returns the known LayoutConstraintHorizontal values.
+func LayoutConstraintHorizontalValues() []LayoutConstraintHorizontal {
+	return []LayoutConstraintHorizontal{
+		LayoutConstraintHorizontalLEFT,
+		LayoutConstraintHorizontalRIGHT,
+		LayoutConstraintHorizontalCENTER,
+		LayoutConstraintHorizontalLEFT_RIGHT,
+		LayoutConstraintHorizontalSCALE,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutGridPattern) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutGridPattern.
+func (v LayoutGridPattern) IsValid() bool {
+	switch v {
+	case LayoutGridPatternCOLUMNS,
+		LayoutGridPatternROWS,
+		LayoutGridPatternGRID:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutGridPattern) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutGridPattern) UnmarshalText(text []byte) error {
+	*v = LayoutGridPattern(text)
+	return nil
+}
+
+// LayoutGridPatternValues returns the known LayoutGridPattern values.
+func LayoutGridPatternValues() []LayoutGridPattern {
+	return []LayoutGridPattern{
+		LayoutGridPatternCOLUMNS,
+		LayoutGridPatternROWS,
+		LayoutGridPatternGRID,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutGridAlignment) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutGridAlignment.
+func (v LayoutGridAlignment) IsValid() bool {
+	switch v {
+	case LayoutGridAlignmentMIN,
+		LayoutGridAlignmentMAX,
+		LayoutGridAlignmentCENTER:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutGridAlignment) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutGridAlignment) UnmarshalText(text []byte) error {
+	*v = LayoutGridAlignment(text)
+	return nil
+}
+
+// LayoutGridAlignmentValues returns the known LayoutGridAlignment values.
+func LayoutGridAlignmentValues() []LayoutGridAlignment {
+	return []LayoutGridAlignment{
+		LayoutGridAlignmentMIN,
+		LayoutGridAlignmentMAX,
+		LayoutGridAlignmentCENTER,
+	}
+}
+
+// String returns the value as a string.
+func (v EffectType) String() string { return string(v) }
+
+// IsValid reports whether v is a known EffectType.
+func (v EffectType) IsValid() bool {
+	switch v {
+	case EffectTypeINNER_SHADOW,
+		EffectTypeDROP_SHADOW,
+		EffectTypeLAYER_BLUR,
+		EffectTypeBACKGROUND_BLUR:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v EffectType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *EffectType) UnmarshalText(text []byte) error {
+	*v = EffectType(text)
+	return nil
+}
+
+// EffectTypeValues returns the known EffectType values.
+func EffectTypeValues() []EffectType {
+	return []EffectType{
+		EffectTypeINNER_SHADOW,
+		EffectTypeDROP_SHADOW,
+		EffectTypeLAYER_BLUR,
+		EffectTypeBACKGROUND_BLUR,
+	}
+}
+
+// String returns the value as a string.
+func (v PaintType) String() string { return string(v) }
+
+// IsValid reports whether v is a known PaintType.
+func (v PaintType) IsValid() bool {
+	switch v {
+	case PaintTypeSOLID,
+		PaintTypeGRADIENT_LINEAR,
+		PaintTypeGRADIENT_RADIAL,
+		PaintTypeGRADIENT_ANGULAR,
+		PaintTypeGRADIENT_DIAMOND,
+		PaintTypeIMAGE,
+		PaintTypeEMOJI:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v PaintType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *PaintType) UnmarshalText(text []byte) error {
+	*v = PaintType(text)
+	return nil
+}
+
+// PaintTypeValues returns the known PaintType values.
+func PaintTypeValues() []PaintType {
+	return []PaintType{
+		PaintTypeSOLID,
+		PaintTypeGRADIENT_LINEAR,
+		PaintTypeGRADIENT_RADIAL,
+		PaintTypeGRADIENT_ANGULAR,
+		PaintTypeGRADIENT_DIAMOND,
+		PaintTypeIMAGE,
+		PaintTypeEMOJI,
+	}
+}
+
+// String returns the value as a string.
+func (v ScaleMode) String() string { return string(v) }
+
+// IsValid reports whether v is a known ScaleMode.
+func (v ScaleMode) IsValid() bool {
+	switch v {
+	case ScaleModeFILL,
+		ScaleModeFIT,
+		ScaleModeTILE,
+		ScaleModeSTRETCH:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ScaleMode) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ScaleMode) UnmarshalText(text []byte) error {
+	*v = ScaleMode(text)
+	return nil
+}
+
+// ScaleModeValues returns the known ScaleMode values.
+func ScaleModeValues() []ScaleMode {
+	return []ScaleMode{
+		ScaleModeFILL,
+		ScaleModeFIT,
+		ScaleModeTILE,
+		ScaleModeSTRETCH,
+	}
+}
+
+// String returns the value as a string.
+func (v StrokeCap) String() string { return string(v) }
+
+// IsValid reports whether v is a known StrokeCap.
+func (v StrokeCap) IsValid() bool {
+	switch v {
+	case StrokeCapNONE,
+		StrokeCapROUND,
+		StrokeCapSQUARE,
+		StrokeCapLINE_ARROW,
+		StrokeCapTRIANGLE_ARROW,
+		StrokeCapDIAMOND_FILLED,
+		StrokeCapCIRCLE_FILLED,
+		StrokeCapTRIANGLE_FILLED,
+		StrokeCapWASHI_TAPE_1,
+		StrokeCapWASHI_TAPE_2,
+		StrokeCapWASHI_TAPE_3,
+		StrokeCapWASHI_TAPE_4,
+		StrokeCapWASHI_TAPE_5,
+		StrokeCapWASHI_TAPE_6:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v StrokeCap) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *StrokeCap) UnmarshalText(text []byte) error {
+	*v = StrokeCap(text)
+	return nil
+}
+
+// StrokeCapValues returns the known StrokeCap values.
+func StrokeCapValues() []StrokeCap {
+	return []StrokeCap{
+		StrokeCapNONE,
+		StrokeCapROUND,
+		StrokeCapSQUARE,
+		StrokeCapLINE_ARROW,
+		StrokeCapTRIANGLE_ARROW,
+		StrokeCapDIAMOND_FILLED,
+		StrokeCapCIRCLE_FILLED,
+		StrokeCapTRIANGLE_FILLED,
+		StrokeCapWASHI_TAPE_1,
+		StrokeCapWASHI_TAPE_2,
+		StrokeCapWASHI_TAPE_3,
+		StrokeCapWASHI_TAPE_4,
+		StrokeCapWASHI_TAPE_5,
+		StrokeCapWASHI_TAPE_6,
+	}
+}
+
+// String returns the value as a string.
+func (v StrokeJoin) String() string { return string(v) }
+
+// IsValid reports whether v is a known StrokeJoin.
+func (v StrokeJoin) IsValid() bool {
+	switch v {
+	case StrokeJoinMITER,
+		StrokeJoinBEVEL,
+		StrokeJoinROUND:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v StrokeJoin) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *StrokeJoin) UnmarshalText(text []byte) error {
+	*v = StrokeJoin(text)
+	return nil
+}
+
+// StrokeJoinValues returns the known StrokeJoin values.
+func StrokeJoinValues() []StrokeJoin {
+	return []StrokeJoin{
+		StrokeJoinMITER,
+		StrokeJoinBEVEL,
+		StrokeJoinROUND,
+	}
+}
+
+// String returns the value as a string.
+func (v WindingRule) String() string { return string(v) }
+
+// IsValid reports whether v is a known WindingRule.
+func (v WindingRule) IsValid() bool {
+	switch v {
+	case WindingRuleNONZERO,
+		WindingRuleEVENODD,
+		WindingRuleNONE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v WindingRule) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *WindingRule) UnmarshalText(text []byte) error {
+	*v = WindingRule(text)
+	return nil
+}
+
+// WindingRuleValues returns the known WindingRule values.
+func WindingRuleValues() []WindingRule {
+	return []WindingRule{
+		WindingRuleNONZERO,
+		WindingRuleEVENODD,
+		WindingRuleNONE,
+	}
+}
+
+// String returns the value as a string.
+func (v TextAlignHorizontal) String() string { return string(v) }
+
+// IsValid reports whether v is a known TextAlignHorizontal.
+func (v TextAlignHorizontal) IsValid() bool {
+	switch v {
+	case TextAlignHorizontalLEFT,
+		TextAlignHorizontalRIGHT,
+		TextAlignHorizontalCENTER,
+		TextAlignHorizontalJUSTIFIED:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v TextAlignHorizontal) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *TextAlignHorizontal) UnmarshalText(text []byte) error {
+	*v = TextAlignHorizontal(text)
+	return nil
+}
+
+// TextAlignHorizontalValues returns the known TextAlignHorizontal values.
+func TextAlignHorizontalValues() []TextAlignHorizontal {
+	return []TextAlignHorizontal{
+		TextAlignHorizontalLEFT,
+		TextAlignHorizontalRIGHT,
+		TextAlignHorizontalCENTER,
+		TextAlignHorizontalJUSTIFIED,
+	}
+}
+
+// String returns the value as a string.
+func (v TextAlignVertical) String() string { return string(v) }
+
+// IsValid reports whether v is a known TextAlignVertical.
+func (v TextAlignVertical) IsValid() bool {
+	switch v {
+	case TextAlignVerticalTOP,
+		TextAlignVerticalCENTER,
+		TextAlignVerticalBOTTOM:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v TextAlignVertical) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *TextAlignVertical) UnmarshalText(text []byte) error {
+	*v = TextAlignVertical(text)
+	return nil
+}
+
+// TextAlignVerticalValues returns the known TextAlignVertical values.
+func TextAlignVerticalValues() []TextAlignVertical {
+	return []TextAlignVertical{
+		TextAlignVerticalTOP,
+		TextAlignVerticalCENTER,
+		TextAlignVerticalBOTTOM,
+	}
+}
+
+// String returns the value as a string.
+func (v TextCase) String() string { return string(v) }
+
+// IsValid reports whether v is a known TextCase.
+func (v TextCase) IsValid() bool {
+	switch v {
+	case TextCaseORIGINAL,
+		TextCaseUPPER,
+		TextCaseLOWER,
+		TextCaseTITLE,
+		TextCaseSMALL_CAPS,
+		TextCaseSMALL_CAPS_FORCED:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v TextCase) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *TextCase) UnmarshalText(text []byte) error {
+	*v = TextCase(text)
+	return nil
+}
+
+// TextCaseValues returns the known TextCase values.
+func TextCaseValues() []TextCase {
+	return []TextCase{
+		TextCaseORIGINAL,
+		TextCaseUPPER,
+		TextCaseLOWER,
+		TextCaseTITLE,
+		TextCaseSMALL_CAPS,
+		TextCaseSMALL_CAPS_FORCED,
+	}
+}
+
+// String returns the value as a string.
+func (v TextDecoration) String() string { return string(v) }
+
+// IsValid reports whether v is a known TextDecoration.
+func (v TextDecoration) IsValid() bool {
+	switch v {
+	case TextDecorationNONE,
+		TextDecorationSTRIKETHROUGH,
+		TextDecorationUNDERLINE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v TextDecoration) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *TextDecoration) UnmarshalText(text []byte) error {
+	*v = TextDecoration(text)
+	return nil
+}
+
+// TextDecorationValues returns the known TextDecoration values.
+func TextDecorationValues() []TextDecoration {
+	return []TextDecoration{
+		TextDecorationNONE,
+		TextDecorationSTRIKETHROUGH,
+		TextDecorationUNDERLINE,
+	}
+}
+
+// String returns the value as a string.
+func (v SemanticWeight) String() string { return string(v) }
+
+// IsValid reports whether v is a known SemanticWeight.
+func (v SemanticWeight) IsValid() bool {
+	switch v {
+	case SemanticWeightBOLD,
+		SemanticWeightNORMAL:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v SemanticWeight) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *SemanticWeight) UnmarshalText(text []byte) error {
+	*v = SemanticWeight(text)
+	return nil
+}
+
+// SemanticWeightValues returns the known SemanticWeight values.
+func SemanticWeightValues() []SemanticWeight {
+	return []SemanticWeight{
+		SemanticWeightBOLD,
+		SemanticWeightNORMAL,
+	}
+}
+
+// String returns the value as a string.
+func (v SemanticItalic) String() string { return string(v) }
+
+// IsValid reports whether v is a known SemanticItalic.
+func (v SemanticItalic) IsValid() bool {
+	switch v {
+	case SemanticItalicITALIC,
+		SemanticItalicNORMAL:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v SemanticItalic) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *SemanticItalic) UnmarshalText(text []byte) error {
+	*v = SemanticItalic(text)
+	return nil
+}
+
+// SemanticItalicValues returns the known SemanticItalic values.
+func SemanticItalicValues() []SemanticItalic {
+	return []SemanticItalic{
+		SemanticItalicITALIC,
+		SemanticItalicNORMAL,
+	}
+}
+
+// String returns the value as a string.
+func (v HyperlinkType) String() string { return string(v) }
+
+// IsValid reports whether v is a known HyperlinkType.
+func (v HyperlinkType) IsValid() bool {
+	switch v {
+	case HyperlinkTypeURL,
+		HyperlinkTypeNODE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v HyperlinkType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *HyperlinkType) UnmarshalText(text []byte) error {
+	*v = HyperlinkType(text)
+	return nil
+}
+
+// HyperlinkTypeValues returns the known HyperlinkType values.
+func HyperlinkTypeValues() []HyperlinkType {
+	return []HyperlinkType{
+		HyperlinkTypeURL,
+		HyperlinkTypeNODE,
+	}
+}
+
+// String returns the value as a string.
+func (v StyleType) String() string { return string(v) }
+
+// IsValid reports whether v is a known StyleType.
+func (v StyleType) IsValid() bool {
+	switch v {
+	case StyleTypeFILL,
+		StyleTypeSTROKE,
+		StyleTypeTEXT,
+		StyleTypeEFFECT,
+		StyleTypeGRID:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v StyleType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *StyleType) UnmarshalText(text []byte) error {
+	*v = StyleType(text)
+	return nil
+}
+
+// StyleTypeValues returns the known StyleType values.
+func StyleTypeValues() []StyleType {
+	return []StyleType{
+		StyleTypeFILL,
+		StyleTypeSTROKE,
+		StyleTypeTEXT,
+		StyleTypeEFFECT,
+		StyleTypeGRID,
+	}
+}
+
+// String returns the value as a string.
+func (v ShapeType) String() string { return string(v) }
+
+// IsValid reports whether v is a known ShapeType.
+func (v ShapeType) IsValid() bool {
+	switch v {
+	case ShapeTypeSQUARE,
+		ShapeTypeELLIPSE,
+		ShapeTypeROUNDED_RECTANGLE,
+		ShapeTypeDIAMOND,
+		ShapeTypeTRIANGLE_UP,
+		ShapeTypeTRIANGLE_DOWN,
+		ShapeTypePARALLELOGRAM_RIGHT,
+		ShapeTypePARALLELOGRAM_LEFT,
+		ShapeTypeENG_DATABASE,
+		ShapeTypeENG_QUEUE,
+		ShapeTypeENG_FILE,
+		ShapeTypeENG_FOLDER,
+		ShapeTypeTRAPEZOID,
+		ShapeTypePREDEFINED_PROCESS,
+		ShapeTypeSHIELD,
+		ShapeTypeDOCUMENT_SINGLE,
+		ShapeTypeDOCUMENT_MULTIPLE,
+		ShapeTypeMANUAL_INPUT,
+		ShapeTypeHEXAGON,
+		ShapeTypeCHEVRON,
+		ShapeTypePENTAGON,
+		ShapeTypeOCTAGON,
+		ShapeTypeSTAR,
+		ShapeTypePLUS,
+		ShapeTypeARROW_LEFT,
+		ShapeTypeARROW_RIGHT,
+		ShapeTypeSUMMING_JUNCTION,
+		ShapeTypeOR,
+		ShapeTypeSPEECH_BUBBLE,
+		ShapeTypeINTERNAL_STORAGE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ShapeType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ShapeType) UnmarshalText(text []byte) error {
+	*v = ShapeType(text)
+	return nil
+}
+
+// ShapeTypeValues returns the known ShapeType values.
+func ShapeTypeValues() []ShapeType {
+	return []ShapeType{
+		ShapeTypeSQUARE,
+		ShapeTypeELLIPSE,
+		ShapeTypeROUNDED_RECTANGLE,
+		ShapeTypeDIAMOND,
+		ShapeTypeTRIANGLE_UP,
+		ShapeTypeTRIANGLE_DOWN,
+		ShapeTypePARALLELOGRAM_RIGHT,
+		ShapeTypePARALLELOGRAM_LEFT,
+		ShapeTypeENG_DATABASE,
+		ShapeTypeENG_QUEUE,
+		ShapeTypeENG_FILE,
+		ShapeTypeENG_FOLDER,
+		ShapeTypeTRAPEZOID,
+		ShapeTypePREDEFINED_PROCESS,
+		ShapeTypeSHIELD,
+		ShapeTypeDOCUMENT_SINGLE,
+		ShapeTypeDOCUMENT_MULTIPLE,
+		ShapeTypeMANUAL_INPUT,
+		ShapeTypeHEXAGON,
+		ShapeTypeCHEVRON,
+		ShapeTypePENTAGON,
+		ShapeTypeOCTAGON,
+		ShapeTypeSTAR,
+		ShapeTypePLUS,
+		ShapeTypeARROW_LEFT,
+		ShapeTypeARROW_RIGHT,
+		ShapeTypeSUMMING_JUNCTION,
+		ShapeTypeOR,
+		ShapeTypeSPEECH_BUBBLE,
+		ShapeTypeINTERNAL_STORAGE,
+	}
+}
+
+// String returns the value as a string.
+func (v ConnectorLineType) String() string { return string(v) }
+
+// IsValid reports whether v is a known ConnectorLineType.
+func (v ConnectorLineType) IsValid() bool {
+	switch v {
+	case ConnectorLineTypeSTRAIGHT,
+		ConnectorLineTypeELBOWED,
+		ConnectorLineTypeCURVED:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ConnectorLineType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ConnectorLineType) UnmarshalText(text []byte) error {
+	*v = ConnectorLineType(text)
+	return nil
+}
+
+// ConnectorLineTypeValues returns the known ConnectorLineType values.
+func ConnectorLineTypeValues() []ConnectorLineType {
+	return []ConnectorLineType{
+		ConnectorLineTypeSTRAIGHT,
+		ConnectorLineTypeELBOWED,
+		ConnectorLineTypeCURVED,
+	}
+}
+
+// String returns the value as a string.
+func (v ConnectorStrokeCap) String() string { return string(v) }
+
+// IsValid reports whether v is a known ConnectorStrokeCap.
+func (v ConnectorStrokeCap) IsValid() bool {
+	switch v {
+	case ConnectorStrokeCapNONE,
+		ConnectorStrokeCapLINE_ARROW,
+		ConnectorStrokeCapTRIANGLE_ARROW,
+		ConnectorStrokeCapDIAMOND_FILLED,
+		ConnectorStrokeCapCIRCLE_FILLED,
+		ConnectorStrokeCapTRIANGLE_FILLED:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ConnectorStrokeCap) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ConnectorStrokeCap) UnmarshalText(text []byte) error {
+	*v = ConnectorStrokeCap(text)
+	return nil
+}
+
+// ConnectorStrokeCapValues returns the known ConnectorStrokeCap values.
+func ConnectorStrokeCapValues() []ConnectorStrokeCap {
+	return []ConnectorStrokeCap{
+		ConnectorStrokeCapNONE,
+		ConnectorStrokeCapLINE_ARROW,
+		ConnectorStrokeCapTRIANGLE_ARROW,
+		ConnectorStrokeCapDIAMOND_FILLED,
+		ConnectorStrokeCapCIRCLE_FILLED,
+		ConnectorStrokeCapTRIANGLE_FILLED,
+	}
+}
+
+// String returns the value as a string.
+func (v ConnectorMagnet) String() string { return string(v) }
+
+// IsValid reports whether v is a known ConnectorMagnet.
+func (v ConnectorMagnet) IsValid() bool {
+	switch v {
+	case ConnectorMagnetAUTO,
+		ConnectorMagnetTOP,
+		ConnectorMagnetBOTTOM,
+		ConnectorMagnetLEFT,
+		ConnectorMagnetRIGHT,
+		ConnectorMagnetCENTER:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ConnectorMagnet) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ConnectorMagnet) UnmarshalText(text []byte) error {
+	*v = ConnectorMagnet(text)
+	return nil
+}
+
+// ConnectorMagnetValues returns the known ConnectorMagnet values.
+func ConnectorMagnetValues() []ConnectorMagnet {
+	return []ConnectorMagnet{
+		ConnectorMagnetAUTO,
+		ConnectorMagnetTOP,
+		ConnectorMagnetBOTTOM,
+		ConnectorMagnetLEFT,
+		ConnectorMagnetRIGHT,
+		ConnectorMagnetCENTER,
+	}
+}
+
+// String returns the value as a string.
+func (v DevStatusType) String() string { return string(v) }
+
+// IsValid reports whether v is a known DevStatusType.
+func (v DevStatusType) IsValid() bool {
+	switch v {
+	case DevStatusTypeNONE,
+		DevStatusTypeREADY_FOR_DEV,
+		DevStatusTypeCOMPLETED:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v DevStatusType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *DevStatusType) UnmarshalText(text []byte) error {
+	*v = DevStatusType(text)
+	return nil
+}
+
+// DevStatusTypeValues returns the known DevStatusType values.
+func DevStatusTypeValues() []DevStatusType {
+	return []DevStatusType{
+		DevStatusTypeNONE,
+		DevStatusTypeREADY_FOR_DEV,
+		DevStatusTypeCOMPLETED,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutMode) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutMode.
+func (v LayoutMode) IsValid() bool {
+	switch v {
+	case LayoutModeNONE,
+		LayoutModeHORIZONTAL,
+		LayoutModeVERTICAL,
+		LayoutModeGRID:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutMode) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutMode) UnmarshalText(text []byte) error {
+	*v = LayoutMode(text)
+	return nil
+}
+
+// LayoutModeValues returns the known LayoutMode values.
+func LayoutModeValues() []LayoutMode {
+	return []LayoutMode{
+		LayoutModeNONE,
+		LayoutModeHORIZONTAL,
+		LayoutModeVERTICAL,
+		LayoutModeGRID,
+	}
+}
+
+// String returns the value as a string.
+func (v AxisSizingMode) String() string { return string(v) }
+
+// IsValid reports whether v is a known AxisSizingMode.
+func (v AxisSizingMode) IsValid() bool {
+	switch v {
+	case AxisSizingModeFIXED,
+		AxisSizingModeAUTO:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v AxisSizingMode) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *AxisSizingMode) UnmarshalText(text []byte) error {
+	*v = AxisSizingMode(text)
+	return nil
+}
+
+// AxisSizingModeValues returns the known AxisSizingMode values.
+func AxisSizingModeValues() []AxisSizingMode {
+	return []AxisSizingMode{
+		AxisSizingModeFIXED,
+		AxisSizingModeAUTO,
+	}
+}
+
+// String returns the value as a string.
+func (v PrimaryAxisAlignItems) String() string { return string(v) }
+
+// IsValid reports whether v is a known PrimaryAxisAlignItems.
+func (v PrimaryAxisAlignItems) IsValid() bool {
+	switch v {
+	case PrimaryAxisAlignItemsMIN,
+		PrimaryAxisAlignItemsCENTER,
+		PrimaryAxisAlignItemsMAX,
+		PrimaryAxisAlignItemsSPACE_BETWEEN:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v PrimaryAxisAlignItems) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *PrimaryAxisAlignItems) UnmarshalText(text []byte) error {
+	*v = PrimaryAxisAlignItems(text)
+	return nil
+}
+
+// PrimaryAxisAlignItemsValues returns the known PrimaryAxisAlignItems values.
+func PrimaryAxisAlignItemsValues() []PrimaryAxisAlignItems {
+	return []PrimaryAxisAlignItems{
+		PrimaryAxisAlignItemsMIN,
+		PrimaryAxisAlignItemsCENTER,
+		PrimaryAxisAlignItemsMAX,
+		PrimaryAxisAlignItemsSPACE_BETWEEN,
+	}
+}
+
+// String returns the value as a string.
+func (v CounterAxisAlignItems) String() string { return string(v) }
+
+// IsValid reports whether v is a known CounterAxisAlignItems.
+func (v CounterAxisAlignItems) IsValid() bool {
+	switch v {
+	case CounterAxisAlignItemsMIN,
+		CounterAxisAlignItemsCENTER,
+		CounterAxisAlignItemsMAX,
+		CounterAxisAlignItemsBASELINE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v CounterAxisAlignItems) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *CounterAxisAlignItems) UnmarshalText(text []byte) error {
+	*v = CounterAxisAlignItems(text)
+	return nil
+}
+
+// CounterAxisAlignItemsValues returns the known CounterAxisAlignItems values.
+func CounterAxisAlignItemsValues() []CounterAxisAlignItems {
+	return []CounterAxisAlignItems{
+		CounterAxisAlignItemsMIN,
+		CounterAxisAlignItemsCENTER,
+		CounterAxisAlignItemsMAX,
+		CounterAxisAlignItemsBASELINE,
+	}
+}
+
+// String returns the value as a string.
+func (v CounterAxisAlignContent) String() string { return string(v) }
+
+// IsValid reports whether v is a known CounterAxisAlignContent.
+func (v CounterAxisAlignContent) IsValid() bool {
+	switch v {
+	case CounterAxisAlignContentAUTO,
+		CounterAxisAlignContentSPACE_BETWEEN:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v CounterAxisAlignContent) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *CounterAxisAlignContent) UnmarshalText(text []byte) error {
+	*v = CounterAxisAlignContent(text)
+	return nil
+}
+
+// CounterAxisAlignContentValues returns the known CounterAxisAlignContent values.
+func CounterAxisAlignContentValues() []CounterAxisAlignContent {
+	return []CounterAxisAlignContent{
+		CounterAxisAlignContentAUTO,
+		CounterAxisAlignContentSPACE_BETWEEN,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutWrap) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutWrap.
+func (v LayoutWrap) IsValid() bool {
+	switch v {
+	case LayoutWrapNO_WRAP,
+		LayoutWrapWRAP:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutWrap) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutWrap) UnmarshalText(text []byte) error {
+	*v = LayoutWrap(text)
+	return nil
+}
+
+// LayoutWrapValues returns the known LayoutWrap values.
+func LayoutWrapValues() []LayoutWrap {
+	return []LayoutWrap{
+		LayoutWrapNO_WRAP,
+		LayoutWrapWRAP,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutAlign) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutAlign.
+func (v LayoutAlign) IsValid() bool {
+	switch v {
+	case LayoutAlignINHERIT,
+		LayoutAlignSTRETCH,
+		LayoutAlignMIN,
+		LayoutAlignCENTER,
+		LayoutAlignMAX:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutAlign) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutAlign) UnmarshalText(text []byte) error {
+	*v = LayoutAlign(text)
+	return nil
+}
+
+// LayoutAlignValues returns the known LayoutAlign values.
+func LayoutAlignValues() []LayoutAlign {
+	return []LayoutAlign{
+		LayoutAlignINHERIT,
+		LayoutAlignSTRETCH,
+		LayoutAlignMIN,
+		LayoutAlignCENTER,
+		LayoutAlignMAX,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutPositioning) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutPositioning.
+func (v LayoutPositioning) IsValid() bool {
+	switch v {
+	case LayoutPositioningAUTO,
+		LayoutPositioningABSOLUTE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutPositioning) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutPositioning) UnmarshalText(text []byte) error {
+	*v = LayoutPositioning(text)
+	return nil
+}
+
+// LayoutPositioningValues returns the known LayoutPositioning values.
+func LayoutPositioningValues() []LayoutPositioning {
+	return []LayoutPositioning{
+		LayoutPositioningAUTO,
+		LayoutPositioningABSOLUTE,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutSizing) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutSizing.
+func (v LayoutSizing) IsValid() bool {
+	switch v {
+	case LayoutSizingFIXED,
+		LayoutSizingHUG,
+		LayoutSizingFILL:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutSizing) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutSizing) UnmarshalText(text []byte) error {
+	*v = LayoutSizing(text)
+	return nil
+}
+
+// LayoutSizingValues returns the known LayoutSizing values.
+func LayoutSizingValues() []LayoutSizing {
+	return []LayoutSizing{
+		LayoutSizingFIXED,
+		LayoutSizingHUG,
+		LayoutSizingFILL,
+	}
+}
+
+// String returns the value as a string.
+func (v ComponentPropertyType) String() string { return string(v) }
+
+// IsValid reports whether v is a known ComponentPropertyType.
+func (v ComponentPropertyType) IsValid() bool {
+	switch v {
+	case ComponentPropertyTypeBOOLEAN,
+		ComponentPropertyTypeTEXT,
+		ComponentPropertyTypeINSTANCE_SWAP,
+		ComponentPropertyTypeVARIANT:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ComponentPropertyType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ComponentPropertyType) UnmarshalText(text []byte) error {
+	*v = ComponentPropertyType(text)
+	return nil
+}
+
+// ComponentPropertyTypeValues returns the known ComponentPropertyType values.
+func ComponentPropertyTypeValues() []ComponentPropertyType {
+	return []ComponentPropertyType{
+		ComponentPropertyTypeBOOLEAN,
+		ComponentPropertyTypeTEXT,
+		ComponentPropertyTypeINSTANCE_SWAP,
+		ComponentPropertyTypeVARIANT,
+	}
+}
+
+// String returns the value as a string.
+func (v PreferredValueType) String() string { return string(v) }
+
+// IsValid reports whether v is a known PreferredValueType.
+func (v PreferredValueType) IsValid() bool {
+	switch v {
+	case PreferredValueTypeCOMPONENT,
+		PreferredValueTypeCOMPONENT_SET:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v PreferredValueType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *PreferredValueType) UnmarshalText(text []byte) error {
+	*v = PreferredValueType(text)
+	return nil
+}
+
+// PreferredValueTypeValues returns the known PreferredValueType values.
+func PreferredValueTypeValues() []PreferredValueType {
+	return []PreferredValueType{
+		PreferredValueTypeCOMPONENT,
+		PreferredValueTypeCOMPONENT_SET,
+	}
+}
+
+// String returns the value as a string.
+func (v TriggerType) String() string { return string(v) }
+
+// IsValid reports whether v is a known TriggerType.
+func (v TriggerType) IsValid() bool {
+	switch v {
+	case TriggerTypeON_CLICK,
+		TriggerTypeON_HOVER,
+		TriggerTypeON_PRESS,
+		TriggerTypeON_DRAG,
+		TriggerTypeAFTER_TIMEOUT,
+		TriggerTypeMOUSE_ENTER,
+		TriggerTypeMOUSE_LEAVE,
+		TriggerTypeMOUSE_UP,
+		TriggerTypeMOUSE_DOWN,
+		TriggerTypeON_KEY_DOWN,
+		TriggerTypeON_MEDIA_HIT,
+		TriggerTypeON_MEDIA_END,
+		TriggerTypeON_VOICE:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v TriggerType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *TriggerType) UnmarshalText(text []byte) error {
+	*v = TriggerType(text)
+	return nil
+}
+
+// TriggerTypeValues returns the known TriggerType values.
+func TriggerTypeValues() []TriggerType {
+	return []TriggerType{
+		TriggerTypeON_CLICK,
+		TriggerTypeON_HOVER,
+		TriggerTypeON_PRESS,
+		TriggerTypeON_DRAG,
+		TriggerTypeAFTER_TIMEOUT,
+		TriggerTypeMOUSE_ENTER,
+		TriggerTypeMOUSE_LEAVE,
+		TriggerTypeMOUSE_UP,
+		TriggerTypeMOUSE_DOWN,
+		TriggerTypeON_KEY_DOWN,
+		TriggerTypeON_MEDIA_HIT,
+		TriggerTypeON_MEDIA_END,
+		TriggerTypeON_VOICE,
+	}
+}
+
+// String returns the value as a string.
+func (v ActionType) String() string { return string(v) }
+
+// IsValid reports whether v is a known ActionType.
+func (v ActionType) IsValid() bool {
+	switch v {
+	case ActionTypeBACK,
+		ActionTypeCLOSE,
+		ActionTypeURL,
+		ActionTypeNODE,
+		ActionTypeSET_VARIABLE,
+		ActionTypeSET_VARIABLE_MODE,
+		ActionTypeCONDITIONAL,
+		ActionTypeUPDATE_MEDIA_RUNTIME:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ActionType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ActionType) UnmarshalText(text []byte) error {
+	*v = ActionType(text)
+	return nil
+}
+
+// ActionTypeValues returns the known ActionType values.
+func ActionTypeValues() []ActionType {
+	return []ActionType{
+		ActionTypeBACK,
+		ActionTypeCLOSE,
+		ActionTypeURL,
+		ActionTypeNODE,
+		ActionTypeSET_VARIABLE,
+		ActionTypeSET_VARIABLE_MODE,
+		ActionTypeCONDITIONAL,
+		ActionTypeUPDATE_MEDIA_RUNTIME,
+	}
+}
+
+// String returns the value as a string.
+func (v Navigation) String() string { return string(v) }
+
+// IsValid reports whether v is a known Navigation.
+func (v Navigation) IsValid() bool {
+	switch v {
+	case NavigationNAVIGATE,
+		NavigationSWAP,
+		NavigationOVERLAY,
+		NavigationSCROLL_TO,
+		NavigationCHANGE_TO:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v Navigation) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *Navigation) UnmarshalText(text []byte) error {
+	*v = Navigation(text)
+	return nil
+}
+
+// NavigationValues returns the known Navigation values.
+func NavigationValues() []Navigation {
+	return []Navigation{
+		NavigationNAVIGATE,
+		NavigationSWAP,
+		NavigationOVERLAY,
+		NavigationSCROLL_TO,
+		NavigationCHANGE_TO,
+	}
+}
+
+// String returns the value as a string.
+func (v TransitionType) String() string { return string(v) }
+
+// IsValid reports whether v is a known TransitionType.
+func (v TransitionType) IsValid() bool {
+	switch v {
+	case TransitionTypeDISSOLVE,
+		TransitionTypeSMART_ANIMATE,
+		TransitionTypeSCROLL_ANIMATE,
+		TransitionTypeMOVE_IN,
+		TransitionTypeMOVE_OUT,
+		TransitionTypePUSH,
+		TransitionTypeSLIDE_IN,
+		TransitionTypeSLIDE_OUT:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v TransitionType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *TransitionType) UnmarshalText(text []byte) error {
+	*v = TransitionType(text)
+	return nil
+}
+
+// TransitionTypeValues returns the known TransitionType values.
+func TransitionTypeValues() []TransitionType {
+	return []TransitionType{
+		TransitionTypeDISSOLVE,
+		TransitionTypeSMART_ANIMATE,
+		TransitionTypeSCROLL_ANIMATE,
+		TransitionTypeMOVE_IN,
+		TransitionTypeMOVE_OUT,
+		TransitionTypePUSH,
+		TransitionTypeSLIDE_IN,
+		TransitionTypeSLIDE_OUT,
+	}
+}
+
+// String returns the value as a string.
+func (v EasingType) String() string { return string(v) }
+
+// IsValid reports whether v is a known EasingType.
+func (v EasingType) IsValid() bool {
+	switch v {
+	case EasingTypeLINEAR,
+		EasingTypeEASE_IN,
+		EasingTypeEASE_OUT,
+		EasingTypeEASE_IN_AND_OUT,
+		EasingTypeEASE_IN_BACK,
+		EasingTypeEASE_OUT_BACK,
+		EasingTypeEASE_IN_AND_OUT_BACK,
+		EasingTypeCUSTOM_CUBIC_BEZIER,
+		EasingTypeGENTLE,
+		EasingTypeQUICK,
+		EasingTypeBOUNCY,
+		EasingTypeSLOW,
+		EasingTypeCUSTOM_SPRING:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v EasingType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *EasingType) UnmarshalText(text []byte) error {
+	*v = EasingType(text)
+	return nil
+}
+
+// EasingTypeValues returns the known EasingType values.
+func EasingTypeValues() []EasingType {
+	return []EasingType{
+		EasingTypeLINEAR,
+		EasingTypeEASE_IN,
+		EasingTypeEASE_OUT,
+		EasingTypeEASE_IN_AND_OUT,
+		EasingTypeEASE_IN_BACK,
+		EasingTypeEASE_OUT_BACK,
+		EasingTypeEASE_IN_AND_OUT_BACK,
+		EasingTypeCUSTOM_CUBIC_BEZIER,
+		EasingTypeGENTLE,
+		EasingTypeQUICK,
+		EasingTypeBOUNCY,
+		EasingTypeSLOW,
+		EasingTypeCUSTOM_SPRING,
+	}
+}
+
+// String returns the value as a string.
+func (v VariableResolvedType) String() string { return string(v) }
+
+// IsValid reports whether v is a known VariableResolvedType.
+func (v VariableResolvedType) IsValid() bool {
+	switch v {
+	case VariableResolvedTypeBOOLEAN,
+		VariableResolvedTypeFLOAT,
+		VariableResolvedTypeSTRING,
+		VariableResolvedTypeCOLOR:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v VariableResolvedType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *VariableResolvedType) UnmarshalText(text []byte) error {
+	*v = VariableResolvedType(text)
+	return nil
+}
+
+// VariableResolvedTypeValues returns the known VariableResolvedType values.
+func VariableResolvedTypeValues() []VariableResolvedType {
+	return []VariableResolvedType{
+		VariableResolvedTypeBOOLEAN,
+		VariableResolvedTypeFLOAT,
+		VariableResolvedTypeSTRING,
+		VariableResolvedTypeCOLOR,
+	}
+}
@@ -1,5 +1,15 @@
 package figmatypes
 
+import (
+	"encoding/json"
+	"maps"
+	"math"
+	"reflect"
+	"slices"
+
+	"github.com/tmc/figma/internal/jsonfields"
+)
+
 // VectorOrFrameOffset contains the fields from Vector and FrameOffset.
 type VectorOrFrameOffset struct {
 	X          float64 `json:"x,omitempty"`
@@ -18,13 +28,20 @@ type Color struct {
 	A float64 `json:"a"`
 }
 
+// Channel8 converts a color channel or alpha value from the range [0, 1] to [0, 255],
+// clamping values outside the range.
+func Channel8(v float64) uint8 {
+	return uint8(math.Round(math.Max(0, math.Min(1, v)) * 255))
+}
+
 // FormatType describes the possible image formats.
 type FormatType string
 
 const (
 	FormatTypeJPG FormatType = "JPG"
-	FormatTypePNG            = "PNG"
-	FormatTypeSVG            = "SVG"
+	FormatTypePNG FormatType = "PNG"
+	FormatTypeSVG FormatType = "SVG"
+	FormatTypePDF FormatType = "PDF"
 )
 
 // ExportSetting describes export settings for a Figma object.
@@ -39,8 +56,8 @@ type ConstraintType string
 
 const (
 	ConstraintTypeSCALE  ConstraintType = "SCALE"
-	ConstraintTypeWIDTH                 = "WIDTH"
-	ConstraintTypeHEIGHT                = "HEIGHT"
+	ConstraintTypeWIDTH  ConstraintType = "WIDTH"
+	ConstraintTypeHEIGHT ConstraintType = "HEIGHT"
 )
 
 // A Constraint is a sizing constraint for exports.
@@ -62,44 +79,55 @@ type BlendMode string
 
 const (
 	BlendModePASS_THROUGH BlendMode = "PASS_THROUGH"
-	BlendModeNORMAL                 = "NORMAL"
-	BlendModeDARKEN                 = "DARKEN"
-	BlendModeMULTIPLY               = "MULTIPLY"
-	BlendModeLINEAR_BURN            = "LINEAR_BURN"
-	BlendModeCOLOR_BURN             = "COLOR_BURN"
-	BlendModeLIGHTEN                = "LIGHTEN"
-	BlendModeSCREEN                 = "SCREEN"
-	BlendModeLINEAR_DODGE           = "LINEAR_DODGE"
-	BlendModeCOLOR_DODGE            = "COLOR_DODGE"
-	BlendModeOVERLAY                = "OVERLAY"
-	BlendModeSOFT_LIGHT             = "SOFT_LIGHT"
-	BlendModeHARD_LIGHT             = "HARD_LIGHT"
-	BlendModeDIFFERENCE             = "DIFFERENCE"
-	BlendModeEXCLUSION              = "EXCLUSION"
-	BlendModeHUE                    = "HUE"
-	BlendModeSATURATION             = "SATURATION"
-	BlendModeCOLOR                  = "COLOR"
-	BlendModeLUMINOSITY             = "LUMINOSITY"
+	BlendModeNORMAL       BlendMode = "NORMAL"
+	BlendModeDARKEN       BlendMode = "DARKEN"
+	BlendModeMULTIPLY     BlendMode = "MULTIPLY"
+	BlendModeLINEAR_BURN  BlendMode = "LINEAR_BURN"
+	BlendModeCOLOR_BURN   BlendMode = "COLOR_BURN"
+	BlendModeLIGHTEN      BlendMode = "LIGHTEN"
+	BlendModeSCREEN       BlendMode = "SCREEN"
+	BlendModeLINEAR_DODGE BlendMode = "LINEAR_DODGE"
+	BlendModeCOLOR_DODGE  BlendMode = "COLOR_DODGE"
+	BlendModeOVERLAY      BlendMode = "OVERLAY"
+	BlendModeSOFT_LIGHT   BlendMode = "SOFT_LIGHT"
+	BlendModeHARD_LIGHT   BlendMode = "HARD_LIGHT"
+	BlendModeDIFFERENCE   BlendMode = "DIFFERENCE"
+	BlendModeEXCLUSION    BlendMode = "EXCLUSION"
+	BlendModeHUE          BlendMode = "HUE"
+	BlendModeSATURATION   BlendMode = "SATURATION"
+	BlendModeCOLOR        BlendMode = "COLOR"
+	BlendModeLUMINOSITY   BlendMode = "LUMINOSITY"
 )
 
 type LayoutConstraintVertical string
 
 const (
 	LayoutConstraintVerticalTOP        LayoutConstraintVertical = "TOP"
-	LayoutConstraintVerticalBOTTOM                              = "BOTTOM"
-	LayoutConstraintVerticalCENTER                              = "CENTER"
-	LayoutConstraintVerticalTOP_BOTTOM                          = "TOP_BOTTOM"
-	LayoutConstraintVerticalSCALE                               = "SCALE"
+	LayoutConstraintVerticalBOTTOM     LayoutConstraintVertical = "BOTTOM"
+	LayoutConstraintVerticalCENTER     LayoutConstraintVertical = "CENTER"
+	LayoutConstraintVerticalTOP_BOTTOM LayoutConstraintVertical = "TOP_BOTTOM"
+	LayoutConstraintVerticalSCALE      LayoutConstraintVertical = "SCALE"
 )
 
 type LayoutConstraintHorizontal string
 
 const (
-	LayoutConstraintHoritontalLEFT       LayoutConstraintHorizontal = "LEFT"
-	LayoutConstraintHoritontalRIGHT                                 = "RIGHT"
-	LayoutConstraintHoritontalCENTER                                = "CENTER"
-	LayoutConstraintHoritontalLEFT_RIGHT                            = "LEFT_RIGHT"
-	LayoutConstraintHoritontalSCALE                                 = "SCALE"
+	LayoutConstraintHorizontalLEFT       LayoutConstraintHorizontal = "LEFT"
+	LayoutConstraintHorizontalRIGHT      LayoutConstraintHorizontal = "RIGHT"
+	LayoutConstraintHorizontalCENTER     LayoutConstraintHorizontal = "CENTER"
+	LayoutConstraintHorizontalLEFT_RIGHT LayoutConstraintHorizontal = "LEFT_RIGHT"
+	LayoutConstraintHorizontalSCALE      LayoutConstraintHorizontal = "SCALE"
+)
+
+// Misspelled names of the LayoutConstraintHorizontal values.
+//
+// Deprecated: Use the LayoutConstraintHorizontal constants.
+const (
+	LayoutConstraintHoritontalLEFT       = LayoutConstraintHorizontalLEFT
+	LayoutConstraintHoritontalRIGHT      = LayoutConstraintHorizontalRIGHT
+	LayoutConstraintHoritontalCENTER     = LayoutConstraintHorizontalCENTER
+	LayoutConstraintHoritontalLEFT_RIGHT = LayoutConstraintHorizontalLEFT_RIGHT
+	LayoutConstraintHoritontalSCALE      = LayoutConstraintHorizontalSCALE
 )
 
 type LayoutConstraint struct {
@@ -111,16 +139,16 @@ type LayoutGridPattern string
 
 const (
 	LayoutGridPatternCOLUMNS LayoutGridPattern = "COLUMNS"
-	LayoutGridPatternROWS                      = "ROWS"
-	LayoutGridPatternGRID                      = "GRID"
+	LayoutGridPatternROWS    LayoutGridPattern = "ROWS"
+	LayoutGridPatternGRID    LayoutGridPattern = "GRID"
 )
 
 type LayoutGridAlignment string
 
 const (
 	LayoutGridAlignmentMIN    LayoutGridAlignment = "MIN"
-	LayoutGridAlignmentMAX                        = "MAX"
-	LayoutGridAlignmentCENTER                     = "CENTER"
+	LayoutGridAlignmentMAX    LayoutGridAlignment = "MAX"
+	LayoutGridAlignmentCENTER LayoutGridAlignment = "CENTER"
 )
 
 type LayoutGrid struct {
@@ -128,49 +156,61 @@ type LayoutGrid struct {
 	SectionSize float64             `json:"sectionSize,omitempty"`
 	Color       Color               `json:"color,omitempty"`
 	Alignment   LayoutGridAlignment `json:"alignment,omitempty"`
-	GutterSize  int                 `json:"gutterSize"`
+	GutterSize  float64             `json:"gutterSize"`
 	Count       int                 `json:"count,omitempty"`
-	Offset      int                 `json:"offset"`
+	Offset      float64             `json:"offset"`
 	Visible     *bool               `json:"visible,omitempty"`
 }
 
+// IsVisible reports whether the grid is shown. Visible defaults to true.
+func (g LayoutGrid) IsVisible() bool {
+	return g.Visible == nil || *g.Visible
+}
+
 type EffectType string
 
 const (
 	EffectTypeINNER_SHADOW    EffectType = "INNER_SHADOW"
-	EffectTypeDROP_SHADOW                = "DROP_SHADOW"
-	EffectTypeLAYER_BLUR                 = "LAYER_BLUR"
-	EffectTypeBACKGROUND_BLUR            = "BACKGROUND_BLUR"
+	EffectTypeDROP_SHADOW     EffectType = "DROP_SHADOW"
+	EffectTypeLAYER_BLUR      EffectType = "LAYER_BLUR"
+	EffectTypeBACKGROUND_BLUR EffectType = "BACKGROUND_BLUR"
 )
 
 type Effect struct {
 	Type      EffectType `json:"type"`
 	Visible   *bool      `json:"visible,omitempty"`
 	Color     Color      `json:"color,omitempty"`
-	BlendMode string     `json:"blendMode,omitempty"`
+	BlendMode BlendMode  `json:"blendMode,omitempty"`
 	Radius    float64    `json:"radius,omitempty"`
 	Offset    Vector     `json:"offset,omitempty"`
+	// How far a shadow extends beyond the node, in px.
+	Spread float64 `json:"spread,omitempty"`
+}
+
+// IsVisible reports whether the effect is enabled. Visible defaults to true.
+func (e Effect) IsVisible() bool {
+	return e.Visible == nil || *e.Visible
 }
 
 type PaintType string
 
 const (
 	PaintTypeSOLID            PaintType = "SOLID"
-	PaintTypeGRADIENT_LINEAR            = "GRADIENT_LINEAR"
-	PaintTypeGRADIENT_RADIAL            = "GRADIENT_RADIAL"
-	PaintTypeGRADIENT_ANGULAR           = "GRADIENT_ANGULAR"
-	PaintTypeGRADIENT_DIAMOND           = "GRADIENT_DIAMOND"
-	PaintTypeIMAGE                      = "IMAGE"
-	PaintTypeEMOJI                      = "EMOJI"
+	PaintTypeGRADIENT_LINEAR  PaintType = "GRADIENT_LINEAR"
+	PaintTypeGRADIENT_RADIAL  PaintType = "GRADIENT_RADIAL"
+	PaintTypeGRADIENT_ANGULAR PaintType = "GRADIENT_ANGULAR"
+	PaintTypeGRADIENT_DIAMOND PaintType = "GRADIENT_DIAMOND"
+	PaintTypeIMAGE            PaintType = "IMAGE"
+	PaintTypeEMOJI            PaintType = "EMOJI"
 )
 
 type ScaleMode string
 
 const (
 	ScaleModeFILL    ScaleMode = "FILL"
-	ScaleModeFIT               = "FIT"
-	ScaleModeTILE              = "TILE"
-	ScaleModeSTRETCH           = "STRETCH"
+	ScaleModeFIT     ScaleMode = "FIT"
+	ScaleModeTILE    ScaleMode = "TILE"
+	ScaleModeSTRETCH ScaleMode = "STRETCH"
 )
 
 // Paint is a solid color, gradient, or image texture that can be applied as fills or strokes.
@@ -178,8 +218,9 @@ type Paint struct {
 	Type PaintType `json:"type"`
 	// Is the paint enabled?. default: true.
 	Visible *bool `json:"visible,omitempty"`
-	// Overall opacity of paint (colors within the paint can also have opacity values which would blend with this). default: 1.
-	Opacity float64 `json:"opacity,omitempty"`
+	// Overall opacity of paint (colors within the paint can also have opacity values which would blend with this).
+	// Figma omits it for opaque paints; use GetOpacity to read it.
+	Opacity *float64 `json:"opacity,omitempty"`
 	// Solid color of the paint.
 	Color *Color `json:"color,omitempty"`
 	// This field contains three vectors, each of which are a position in normalized object space (normalized object space is if the top left corner of the bounding box of the object is (0, 0) and the bottom right is (1,1)). The first position corresponds to the start of the gradient (value 0 for the purposes of calculating gradient stops), the second position is the end of the gradient (value 1), and the third handle position determines the width of the gradient (only relevant for non-linear gradients). See image examples below:.
@@ -188,7 +229,118 @@ type Paint struct {
 	// Positions of key points along the gradient axis with the colors anchored there. Colors along the gradient are interpolated smoothly between neighboring gradient stops..
 	GradientStops []ColorStop `json:"gradientStops,omitempty"`
 	// Image scaling mode.
-	ScaleMode string `json:"scaleMode,omitempty"`
+	ScaleMode ScaleMode `json:"scaleMode,omitempty"`
+	// How this paint blends with the paints below it.
+	BlendMode BlendMode `json:"blendMode,omitempty"`
+	// Reference to an image embedded in the file, for image paints. Use Client.GetImageFills to download it.
+	ImageRef string `json:"imageRef,omitempty"`
+	// Reference to the animated GIF of an image paint, if any.
+	GifRef string `json:"gifRef,omitempty"`
+	// The transform applied to the image when ScaleMode is STRETCH, mapping the node's
+	// normalized space to the image's.
+	ImageTransform *Transform `json:"imageTransform,omitempty"`
+	// The amount the image is scaled by when ScaleMode is TILE.
+	ScalingFactor float64 `json:"scalingFactor,omitempty"`
+	// The rotation of the image in degrees, a multiple of 90.
+	Rotation float64 `json:"rotation,omitempty"`
+	// Adjustments applied to the image.
+	Filters *ImageFilters `json:"filters,omitempty"`
+	// The variables bound to fields of the paint, keyed by field name, such as "color".
+	BoundVariables map[string]VariableAlias `json:"boundVariables,omitempty"`
+}
+
+// GetOpacity returns the opacity of the paint: Opacity, or 1 if it is absent.
+// An explicit opacity of 0 makes the paint fully transparent.
+func (p Paint) GetOpacity() float64 {
+	if p.Opacity == nil {
+		return 1
+	}
+	return *p.Opacity
+}
+
+// IsVisible reports whether the paint is enabled. Visible defaults to true.
+func (p Paint) IsVisible() bool {
+	return p.Visible == nil || *p.Visible
+}
+
+// Clone returns a deep copy of p.
+func (p Paint) Clone() Paint {
+	p.Visible = clonePtr(p.Visible)
+	p.Opacity = clonePtr(p.Opacity)
+	p.Color = clonePtr(p.Color)
+	p.GradientHandlePositions = slices.Clone(p.GradientHandlePositions)
+	p.GradientStops = slices.Clone(p.GradientStops)
+	for i, s := range p.GradientStops {
+		p.GradientStops[i].BoundVariables = maps.Clone(s.BoundVariables)
+	}
+	p.ImageTransform = clonePtr(p.ImageTransform)
+	p.Filters = clonePtr(p.Filters)
+	p.BoundVariables = maps.Clone(p.BoundVariables)
+	return p
+}
+
+func clonePtr[T any](p *T) *T {
+	if p == nil {
+		return nil
+	}
+	v := *p
+	return &v
+}
+
+// ImageFilters are adjustments applied to an image paint. Each ranges from -1 to 1.
+type ImageFilters struct {
+	Exposure    float64 `json:"exposure,omitempty"`
+	Contrast    float64 `json:"contrast,omitempty"`
+	Saturation  float64 `json:"saturation,omitempty"`
+	Temperature float64 `json:"temperature,omitempty"`
+	Tint        float64 `json:"tint,omitempty"`
+	Highlights  float64 `json:"highlights,omitempty"`
+	Shadows     float64 `json:"shadows,omitempty"`
+}
+
+// VariableAlias is a reference to a variable.
+type VariableAlias struct {
+	// Always "VARIABLE_ALIAS".
+	Type string `json:"type"`
+	// The ID of the variable.
+	ID string `json:"id"`
+}
+
+// StrokeCap is the decoration at the end of an open stroke.
+type StrokeCap string
+
+const (
+	StrokeCapNONE            StrokeCap = "NONE"
+	StrokeCapROUND           StrokeCap = "ROUND"
+	StrokeCapSQUARE          StrokeCap = "SQUARE"
+	StrokeCapLINE_ARROW      StrokeCap = "LINE_ARROW"
+	StrokeCapTRIANGLE_ARROW  StrokeCap = "TRIANGLE_ARROW"
+	StrokeCapDIAMOND_FILLED  StrokeCap = "DIAMOND_FILLED"
+	StrokeCapCIRCLE_FILLED   StrokeCap = "CIRCLE_FILLED"
+	StrokeCapTRIANGLE_FILLED StrokeCap = "TRIANGLE_FILLED"
+	StrokeCapWASHI_TAPE_1    StrokeCap = "WASHI_TAPE_1"
+	StrokeCapWASHI_TAPE_2    StrokeCap = "WASHI_TAPE_2"
+	StrokeCapWASHI_TAPE_3    StrokeCap = "WASHI_TAPE_3"
+	StrokeCapWASHI_TAPE_4    StrokeCap = "WASHI_TAPE_4"
+	StrokeCapWASHI_TAPE_5    StrokeCap = "WASHI_TAPE_5"
+	StrokeCapWASHI_TAPE_6    StrokeCap = "WASHI_TAPE_6"
+)
+
+// StrokeJoin is the decoration where two stroke segments meet.
+type StrokeJoin string
+
+const (
+	StrokeJoinMITER StrokeJoin = "MITER"
+	StrokeJoinBEVEL StrokeJoin = "BEVEL"
+	StrokeJoinROUND StrokeJoin = "ROUND"
+)
+
+// StrokeWeights are the weights of the strokes on each side of a rectangular node.
+type StrokeWeights struct {
+	Top    float64 `json:"top"`
+	Right  float64 `json:"right"`
+	Bottom float64 `json:"bottom"`
+	Left   float64 `json:"left"`
 }
 
 type Vector struct {
@@ -196,13 +348,26 @@ type Vector struct {
 	Y float64 `json:"y"`
 }
 
-type Transform [][]float64
-
+// Path is a vector path in SVG path data syntax.
 type Path struct {
-	Path        string `json:"path"`
-	WindingRule string `json:"winding_rule"`
+	// The path data, using only absolute M, L, Q, C and Z commands.
+	Path string `json:"path"`
+	// The winding rule used to fill the path.
+	WindingRule WindingRule `json:"windingRule"`
 }
 
+// WindingRule determines which regions enclosed by a path are filled.
+type WindingRule string
+
+const (
+	// Regions with a non-zero winding number are filled.
+	WindingRuleNONZERO WindingRule = "NONZERO"
+	// Regions crossed by an odd number of path segments are filled.
+	WindingRuleEVENODD WindingRule = "EVENODD"
+	// The path is not filled.
+	WindingRuleNONE WindingRule = "NONE"
+)
+
 type FrameOffset struct {
 	NodeID     string `json:"node_id"`
 	NodeOffset Vector `json:"node_offset"`
@@ -211,32 +376,660 @@ type FrameOffset struct {
 type ColorStop struct {
 	Position float64 `json:"position"`
 	Color    Color   `json:"color,omitempty"`
+	// The variables bound to fields of the stop, keyed by field name, such as "color".
+	BoundVariables map[string]VariableAlias `json:"boundVariables,omitempty"`
 }
 
 type TypeStyle struct {
-	FontFamily          string  `json:"fontFamily,omitempty"`
-	FontPostScriptName  string  `json:"fontPostScriptName,omitempty"`
-	Italic              bool    `json:"italic,omitempty"`
-	FontWeight          float64 `json:"fontWeight,omitempty"`
-	FontSize            float64 `json:"fontSize,omitempty"`
-	TextAlignHorizontal string  `json:"textAlignHorizontal,omitempty"`
-	TextAlignVertical   string  `json:"textAlignVertical,omitempty"`
-	LetterSpacing       float64 `json:"letterSpacing"`
-	LineHeightPercent   float64 `json:"lineHeightPercent,omitempty"`
-	LineHeightPx        float64 `json:"lineHeightPx,omitempty"`
-	Fills               []Paint `json:"fills,omitempty"`
-}
-
-// Style is the name of a style.
-type Style string
+	FontFamily          string              `json:"fontFamily,omitempty"`
+	FontPostScriptName  string              `json:"fontPostScriptName,omitempty"`
+	Italic              bool                `json:"italic,omitempty"`
+	FontWeight          float64             `json:"fontWeight,omitempty"`
+	FontSize            float64             `json:"fontSize,omitempty"`
+	TextAlignHorizontal TextAlignHorizontal `json:"textAlignHorizontal,omitempty"`
+	TextAlignVertical   TextAlignVertical   `json:"textAlignVertical,omitempty"`
+	LetterSpacing       float64             `json:"letterSpacing"`
+	// Line height as a percentage of the font's normal line height. Deprecated by Figma.
+	LineHeightPercent float64 `json:"lineHeightPercent,omitempty"`
+	// Line height as a percentage of the font size.
+	LineHeightPercentFontSize float64 `json:"lineHeightPercentFontSize,omitempty"`
+	LineHeightPx              float64 `json:"lineHeightPx,omitempty"`
+	Fills                     []Paint `json:"fills,omitempty"`
+	// Space between paragraphs in px.
+	ParagraphSpacing float64 `json:"paragraphSpacing,omitempty"`
+	// Paragraph indentation in px.
+	ParagraphIndent float64 `json:"paragraphIndent,omitempty"`
+	// Text casing applied to the characters.
+	TextCase TextCase `json:"textCase,omitempty"`
+	// Text decoration applied to the characters.
+	TextDecoration TextDecoration `json:"textDecoration,omitempty"`
+	// The link the characters point to, if any.
+	Hyperlink *Hyperlink `json:"hyperlink,omitempty"`
+	// Whether the characters are bold, for fonts whose weight is not expressed by FontWeight alone.
+	SemanticWeight SemanticWeight `json:"semanticWeight,omitempty"`
+	// Whether the characters are italic, for fonts without an italic flag.
+	SemanticItalic SemanticItalic `json:"semanticItalic,omitempty"`
+
+	// the JSON keys the style was decoded from, if any.
+	present map[string]bool
+}
+
+// UnmarshalJSON decodes a type style, recording which fields were present so that
+// style overrides can be told apart from fields reset to their zero value.
+func (s *TypeStyle) UnmarshalJSON(data []byte) error {
+	type typeStyle TypeStyle
+	if err := json.Unmarshal(data, (*typeStyle)(s)); err != nil {
+		return err
+	}
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return err
+	}
+	s.present = make(map[string]bool, len(keys))
+	for k := range keys {
+		s.present[k] = true
+	}
+	return nil
+}
+
+// Merge returns s with the fields set in override replacing its own. A field is set if it
+// was present in the JSON override was decoded from or, for styles built in Go, if it is non-zero.
+func (s TypeStyle) Merge(override TypeStyle) TypeStyle {
+	result := s
+	dst := reflect.ValueOf(&result).Elem()
+	src := reflect.ValueOf(override)
+	for name, f := range jsonfields.Of(src.Type()) {
+		v := src.FieldByIndex(f.Index)
+		if override.present != nil && !override.present[name] || override.present == nil && v.IsZero() {
+			continue
+		}
+		dst.FieldByIndex(f.Index).Set(v)
+		if result.present != nil {
+			if _, ok := result.present[name]; !ok {
+				result.present = maps.Clone(result.present)
+				result.present[name] = true
+			}
+		}
+	}
+	return result
+}
+
+// Clone returns a deep copy of s, including the record of the fields present in
+// the JSON s was decoded from, which Merge uses.
+func (s TypeStyle) Clone() TypeStyle {
+	if s.Fills != nil {
+		fills := make([]Paint, len(s.Fills))
+		for i, p := range s.Fills {
+			fills[i] = p.Clone()
+		}
+		s.Fills = fills
+	}
+	s.Hyperlink = clonePtr(s.Hyperlink)
+	s.present = maps.Clone(s.present)
+	return s
+}
+
+// Equal reports whether s and t have the same fields, ignoring which were present
+// in the JSON they were decoded from. Without it, go-cmp would panic on that
+// unexported record when comparing type styles or the values holding them.
+func (s TypeStyle) Equal(t TypeStyle) bool {
+	s.present, t.present = nil, nil
+	return reflect.DeepEqual(s, t)
+}
+
+// IsBold reports whether the style renders bold text.
+func (s TypeStyle) IsBold() bool {
+	return s.SemanticWeight == SemanticWeightBOLD || s.FontWeight >= 600
+}
+
+// IsItalic reports whether the style renders italic text.
+func (s TypeStyle) IsItalic() bool {
+	return s.Italic || s.SemanticItalic == SemanticItalicITALIC
+}
+
+// TextAlignHorizontal is the horizontal alignment of text within its box.
+type TextAlignHorizontal string
+
+const (
+	TextAlignHorizontalLEFT      TextAlignHorizontal = "LEFT"
+	TextAlignHorizontalRIGHT     TextAlignHorizontal = "RIGHT"
+	TextAlignHorizontalCENTER    TextAlignHorizontal = "CENTER"
+	TextAlignHorizontalJUSTIFIED TextAlignHorizontal = "JUSTIFIED"
+)
+
+// TextAlignVertical is the vertical alignment of text within its box.
+type TextAlignVertical string
+
+const (
+	TextAlignVerticalTOP    TextAlignVertical = "TOP"
+	TextAlignVerticalCENTER TextAlignVertical = "CENTER"
+	TextAlignVerticalBOTTOM TextAlignVertical = "BOTTOM"
+)
+
+// TextCase is the casing applied to text.
+type TextCase string
+
+const (
+	TextCaseORIGINAL          TextCase = "ORIGINAL"
+	TextCaseUPPER             TextCase = "UPPER"
+	TextCaseLOWER             TextCase = "LOWER"
+	TextCaseTITLE             TextCase = "TITLE"
+	TextCaseSMALL_CAPS        TextCase = "SMALL_CAPS"
+	TextCaseSMALL_CAPS_FORCED TextCase = "SMALL_CAPS_FORCED"
+)
+
+// TextDecoration is a line drawn through or under text.
+type TextDecoration string
+
+const (
+	TextDecorationNONE          TextDecoration = "NONE"
+	TextDecorationSTRIKETHROUGH TextDecoration = "STRIKETHROUGH"
+	TextDecorationUNDERLINE     TextDecoration = "UNDERLINE"
+)
+
+// SemanticWeight is the weight of text as chosen in the editor.
+type SemanticWeight string
+
+const (
+	SemanticWeightBOLD   SemanticWeight = "BOLD"
+	SemanticWeightNORMAL SemanticWeight = "NORMAL"
+)
+
+// SemanticItalic is the slant of text as chosen in the editor.
+type SemanticItalic string
+
+const (
+	SemanticItalicITALIC SemanticItalic = "ITALIC"
+	SemanticItalicNORMAL SemanticItalic = "NORMAL"
+)
+
+// HyperlinkType is the kind of target of a hyperlink.
+type HyperlinkType string
+
+const (
+	HyperlinkTypeURL  HyperlinkType = "URL"
+	HyperlinkTypeNODE HyperlinkType = "NODE"
+)
+
+// Hyperlink is a link from text to a URL or to a node in the same file.
+type Hyperlink struct {
+	Type HyperlinkType `json:"type"`
+	// The URL linked to, for URL links.
+	URL string `json:"url,omitempty"`
+	// The ID of the node linked to, for NODE links.
+	NodeID string `json:"nodeID,omitempty"`
+}
+
+// Style is metadata about a style. File.Styles maps style IDs to Styles.
+type Style struct {
+	// The key of the style.
+	Key string `json:"key,omitempty"`
+	// The name of the style.
+	Name string `json:"name"`
+	// The description of the style as entered by the publisher.
+	Description string `json:"description"`
+	// The type of the style.
+	StyleType StyleType `json:"styleType,omitempty"`
+	// Whether the style comes from a team library.
+	Remote bool `json:"remote,omitempty"`
+}
 
 // StyleType is the type of a style.
 type StyleType string
 
 const (
-	styleTypeFILL   StyleType = "FILL"
-	StyleTypeSTROKE           = "STROKE"
-	StyleTypeTEXT             = "TEXT"
-	StyleTypeEFFECT           = "EFFECT"
-	StyleTypeGRID             = "GRID"
+	StyleTypeFILL   StyleType = "FILL"
+	StyleTypeSTROKE StyleType = "STROKE"
+	StyleTypeTEXT   StyleType = "TEXT"
+	StyleTypeEFFECT StyleType = "EFFECT"
+	StyleTypeGRID   StyleType = "GRID"
 )
+
+// ShapeType is the shape of a FigJam shape with text.
+type ShapeType string
+
+const (
+	ShapeTypeSQUARE              ShapeType = "SQUARE"
+	ShapeTypeELLIPSE             ShapeType = "ELLIPSE"
+	ShapeTypeROUNDED_RECTANGLE   ShapeType = "ROUNDED_RECTANGLE"
+	ShapeTypeDIAMOND             ShapeType = "DIAMOND"
+	ShapeTypeTRIANGLE_UP         ShapeType = "TRIANGLE_UP"
+	ShapeTypeTRIANGLE_DOWN       ShapeType = "TRIANGLE_DOWN"
+	ShapeTypePARALLELOGRAM_RIGHT ShapeType = "PARALLELOGRAM_RIGHT"
+	ShapeTypePARALLELOGRAM_LEFT  ShapeType = "PARALLELOGRAM_LEFT"
+	ShapeTypeENG_DATABASE        ShapeType = "ENG_DATABASE"
+	ShapeTypeENG_QUEUE           ShapeType = "ENG_QUEUE"
+	ShapeTypeENG_FILE            ShapeType = "ENG_FILE"
+	ShapeTypeENG_FOLDER          ShapeType = "ENG_FOLDER"
+	ShapeTypeTRAPEZOID           ShapeType = "TRAPEZOID"
+	ShapeTypePREDEFINED_PROCESS  ShapeType = "PREDEFINED_PROCESS"
+	ShapeTypeSHIELD              ShapeType = "SHIELD"
+	ShapeTypeDOCUMENT_SINGLE     ShapeType = "DOCUMENT_SINGLE"
+	ShapeTypeDOCUMENT_MULTIPLE   ShapeType = "DOCUMENT_MULTIPLE"
+	ShapeTypeMANUAL_INPUT        ShapeType = "MANUAL_INPUT"
+	ShapeTypeHEXAGON             ShapeType = "HEXAGON"
+	ShapeTypeCHEVRON             ShapeType = "CHEVRON"
+	ShapeTypePENTAGON            ShapeType = "PENTAGON"
+	ShapeTypeOCTAGON             ShapeType = "OCTAGON"
+	ShapeTypeSTAR                ShapeType = "STAR"
+	ShapeTypePLUS                ShapeType = "PLUS"
+	ShapeTypeARROW_LEFT          ShapeType = "ARROW_LEFT"
+	ShapeTypeARROW_RIGHT         ShapeType = "ARROW_RIGHT"
+	ShapeTypeSUMMING_JUNCTION    ShapeType = "SUMMING_JUNCTION"
+	ShapeTypeOR                  ShapeType = "OR"
+	ShapeTypeSPEECH_BUBBLE       ShapeType = "SPEECH_BUBBLE"
+	ShapeTypeINTERNAL_STORAGE    ShapeType = "INTERNAL_STORAGE"
+)
+
+// ConnectorLineType describes how a connector is routed.
+type ConnectorLineType string
+
+const (
+	ConnectorLineTypeSTRAIGHT ConnectorLineType = "STRAIGHT"
+	ConnectorLineTypeELBOWED  ConnectorLineType = "ELBOWED"
+	ConnectorLineTypeCURVED   ConnectorLineType = "CURVED"
+)
+
+// ConnectorStrokeCap describes the decoration at an end of a connector.
+type ConnectorStrokeCap string
+
+const (
+	ConnectorStrokeCapNONE            ConnectorStrokeCap = "NONE"
+	ConnectorStrokeCapLINE_ARROW      ConnectorStrokeCap = "LINE_ARROW"
+	ConnectorStrokeCapTRIANGLE_ARROW  ConnectorStrokeCap = "TRIANGLE_ARROW"
+	ConnectorStrokeCapDIAMOND_FILLED  ConnectorStrokeCap = "DIAMOND_FILLED"
+	ConnectorStrokeCapCIRCLE_FILLED   ConnectorStrokeCap = "CIRCLE_FILLED"
+	ConnectorStrokeCapTRIANGLE_FILLED ConnectorStrokeCap = "TRIANGLE_FILLED"
+)
+
+// ConnectorMagnet is the side of a node a connector attaches to.
+type ConnectorMagnet string
+
+const (
+	ConnectorMagnetAUTO   ConnectorMagnet = "AUTO"
+	ConnectorMagnetTOP    ConnectorMagnet = "TOP"
+	ConnectorMagnetBOTTOM ConnectorMagnet = "BOTTOM"
+	ConnectorMagnetLEFT   ConnectorMagnet = "LEFT"
+	ConnectorMagnetRIGHT  ConnectorMagnet = "RIGHT"
+	ConnectorMagnetCENTER ConnectorMagnet = "CENTER"
+)
+
+// ConnectorEndpoint is one end of a connector. It is either attached to a node or positioned freely.
+type ConnectorEndpoint struct {
+	// ID of the node the endpoint is attached to.
+	EndpointNodeID string `json:"endpointNodeId,omitempty"`
+	// Where the endpoint attaches to the node.
+	Magnet ConnectorMagnet `json:"magnet,omitempty"`
+	// Canvas position of an unattached endpoint.
+	Position *Vector `json:"position,omitempty"`
+}
+
+// ConnectorTextBackground is the background behind a connector's label.
+type ConnectorTextBackground struct {
+	// Radius of each corner of the background.
+	CornerRadius float64 `json:"cornerRadius,omitempty"`
+	// An array of fill paints applied to the background.
+	Fills []Paint `json:"fills,omitempty"`
+}
+
+// DevStatusType is the Dev Mode status of a node.
+type DevStatusType string
+
+const (
+	DevStatusTypeNONE          DevStatusType = "NONE"
+	DevStatusTypeREADY_FOR_DEV DevStatusType = "READY_FOR_DEV"
+	DevStatusTypeCOMPLETED     DevStatusType = "COMPLETED"
+)
+
+// DevStatus is the Dev Mode status of a section or top level frame.
+type DevStatus struct {
+	Type        DevStatusType `json:"type"`
+	Description string        `json:"description,omitempty"`
+}
+
+// LayoutMode is the direction of an auto layout frame.
+type LayoutMode string
+
+const (
+	LayoutModeNONE       LayoutMode = "NONE"
+	LayoutModeHORIZONTAL LayoutMode = "HORIZONTAL"
+	LayoutModeVERTICAL   LayoutMode = "VERTICAL"
+	LayoutModeGRID       LayoutMode = "GRID"
+)
+
+// AxisSizingMode is how an auto layout frame is sized along an axis.
+type AxisSizingMode string
+
+const (
+	AxisSizingModeFIXED AxisSizingMode = "FIXED"
+	AxisSizingModeAUTO  AxisSizingMode = "AUTO"
+)
+
+// PrimaryAxisAlignItems is how children of an auto layout frame are aligned along the layout direction.
+type PrimaryAxisAlignItems string
+
+const (
+	PrimaryAxisAlignItemsMIN           PrimaryAxisAlignItems = "MIN"
+	PrimaryAxisAlignItemsCENTER        PrimaryAxisAlignItems = "CENTER"
+	PrimaryAxisAlignItemsMAX           PrimaryAxisAlignItems = "MAX"
+	PrimaryAxisAlignItemsSPACE_BETWEEN PrimaryAxisAlignItems = "SPACE_BETWEEN"
+)
+
+// CounterAxisAlignItems is how children of an auto layout frame are aligned perpendicular to the layout direction.
+type CounterAxisAlignItems string
+
+const (
+	CounterAxisAlignItemsMIN      CounterAxisAlignItems = "MIN"
+	CounterAxisAlignItemsCENTER   CounterAxisAlignItems = "CENTER"
+	CounterAxisAlignItemsMAX      CounterAxisAlignItems = "MAX"
+	CounterAxisAlignItemsBASELINE CounterAxisAlignItems = "BASELINE"
+)
+
+// CounterAxisAlignContent is how wrapped rows or columns of an auto layout frame are distributed.
+type CounterAxisAlignContent string
+
+const (
+	CounterAxisAlignContentAUTO          CounterAxisAlignContent = "AUTO"
+	CounterAxisAlignContentSPACE_BETWEEN CounterAxisAlignContent = "SPACE_BETWEEN"
+)
+
+// LayoutWrap is whether children of an auto layout frame wrap onto new rows or columns.
+type LayoutWrap string
+
+const (
+	LayoutWrapNO_WRAP LayoutWrap = "NO_WRAP"
+	LayoutWrapWRAP    LayoutWrap = "WRAP"
+)
+
+// LayoutAlign is how a child of an auto layout frame is aligned perpendicular to the layout direction.
+type LayoutAlign string
+
+const (
+	LayoutAlignINHERIT LayoutAlign = "INHERIT"
+	LayoutAlignSTRETCH LayoutAlign = "STRETCH"
+	LayoutAlignMIN     LayoutAlign = "MIN"
+	LayoutAlignCENTER  LayoutAlign = "CENTER"
+	LayoutAlignMAX     LayoutAlign = "MAX"
+)
+
+// LayoutPositioning is whether a child of an auto layout frame takes part in the layout.
+type LayoutPositioning string
+
+const (
+	LayoutPositioningAUTO     LayoutPositioning = "AUTO"
+	LayoutPositioningABSOLUTE LayoutPositioning = "ABSOLUTE"
+)
+
+// LayoutSizing is how a node is sized along an axis within auto layout.
+type LayoutSizing string
+
+const (
+	LayoutSizingFIXED LayoutSizing = "FIXED"
+	LayoutSizingHUG   LayoutSizing = "HUG"
+	LayoutSizingFILL  LayoutSizing = "FILL"
+)
+
+// ComponentPropertyType is the type of a component property.
+type ComponentPropertyType string
+
+const (
+	ComponentPropertyTypeBOOLEAN       ComponentPropertyType = "BOOLEAN"
+	ComponentPropertyTypeTEXT          ComponentPropertyType = "TEXT"
+	ComponentPropertyTypeINSTANCE_SWAP ComponentPropertyType = "INSTANCE_SWAP"
+	ComponentPropertyTypeVARIANT       ComponentPropertyType = "VARIANT"
+)
+
+// ComponentPropertyValue is the value of a component property.
+// BOOLEAN properties hold a bool; TEXT, VARIANT and INSTANCE_SWAP properties hold a string.
+type ComponentPropertyValue struct {
+	IsBool bool
+	Bool   bool
+	String string
+}
+
+// MarshalJSON encodes the value as a JSON bool or string.
+func (v ComponentPropertyValue) MarshalJSON() ([]byte, error) {
+	if v.IsBool {
+		return json.Marshal(v.Bool)
+	}
+	return json.Marshal(v.String)
+}
+
+// UnmarshalJSON decodes a JSON bool or string.
+func (v *ComponentPropertyValue) UnmarshalJSON(data []byte) error {
+	*v = ComponentPropertyValue{}
+	if err := json.Unmarshal(data, &v.Bool); err == nil {
+		v.IsBool = true
+		return nil
+	}
+	return json.Unmarshal(data, &v.String)
+}
+
+// PreferredValueType is the type of a preferred value of an INSTANCE_SWAP property.
+type PreferredValueType string
+
+const (
+	PreferredValueTypeCOMPONENT     PreferredValueType = "COMPONENT"
+	PreferredValueTypeCOMPONENT_SET PreferredValueType = "COMPONENT_SET"
+)
+
+// InstanceSwapPreferredValue is a component or component set suggested for an INSTANCE_SWAP property.
+type InstanceSwapPreferredValue struct {
+	Type PreferredValueType `json:"type"`
+	// Key of the component or component set.
+	Key string `json:"key"`
+}
+
+// ComponentPropertyDefinition is a property defined on a component or component set.
+type ComponentPropertyDefinition struct {
+	Type ComponentPropertyType `json:"type"`
+	// Initial value of the property for instances.
+	DefaultValue ComponentPropertyValue `json:"defaultValue"`
+	// All possible values of a VARIANT property.
+	VariantOptions []string `json:"variantOptions,omitempty"`
+	// Suggested components for an INSTANCE_SWAP property.
+	PreferredValues []InstanceSwapPreferredValue `json:"preferredValues,omitempty"`
+}
+
+// ComponentProperty is the value of a component property on an instance.
+type ComponentProperty struct {
+	Type  ComponentPropertyType  `json:"type"`
+	Value ComponentPropertyValue `json:"value"`
+	// Suggested components for an INSTANCE_SWAP property.
+	PreferredValues []InstanceSwapPreferredValue `json:"preferredValues,omitempty"`
+}
+
+// Overrides lists the fields overridden on a node within an instance.
+type Overrides struct {
+	// ID of the overridden node.
+	ID string `json:"id"`
+	// The names of the fields that were overridden.
+	OverriddenFields []string `json:"overriddenFields"`
+}
+
+// TriggerType is the user event that starts a prototype interaction.
+type TriggerType string
+
+const (
+	TriggerTypeON_CLICK      TriggerType = "ON_CLICK"
+	TriggerTypeON_HOVER      TriggerType = "ON_HOVER"
+	TriggerTypeON_PRESS      TriggerType = "ON_PRESS"
+	TriggerTypeON_DRAG       TriggerType = "ON_DRAG"
+	TriggerTypeAFTER_TIMEOUT TriggerType = "AFTER_TIMEOUT"
+	TriggerTypeMOUSE_ENTER   TriggerType = "MOUSE_ENTER"
+	TriggerTypeMOUSE_LEAVE   TriggerType = "MOUSE_LEAVE"
+	TriggerTypeMOUSE_UP      TriggerType = "MOUSE_UP"
+	TriggerTypeMOUSE_DOWN    TriggerType = "MOUSE_DOWN"
+	TriggerTypeON_KEY_DOWN   TriggerType = "ON_KEY_DOWN"
+	TriggerTypeON_MEDIA_HIT  TriggerType = "ON_MEDIA_HIT"
+	TriggerTypeON_MEDIA_END  TriggerType = "ON_MEDIA_END"
+	TriggerTypeON_VOICE      TriggerType = "ON_VOICE"
+)
+
+// Trigger is the user event that starts a prototype interaction.
+type Trigger struct {
+	Type TriggerType `json:"type"`
+	// Seconds to wait for AFTER_TIMEOUT triggers.
+	Timeout float64 `json:"timeout,omitempty"`
+	// Seconds to wait for mouse triggers.
+	Delay float64 `json:"delay,omitempty"`
+	// The input device for ON_KEY_DOWN triggers.
+	Device string `json:"device,omitempty"`
+	// The key codes for ON_KEY_DOWN triggers.
+	KeyCodes []int `json:"keyCodes,omitempty"`
+	// The media position in seconds for ON_MEDIA_HIT triggers.
+	MediaHitTime float64 `json:"mediaHitTime,omitempty"`
+}
+
+// ActionType is the kind of prototype action.
+type ActionType string
+
+const (
+	ActionTypeBACK                 ActionType = "BACK"
+	ActionTypeCLOSE                ActionType = "CLOSE"
+	ActionTypeURL                  ActionType = "URL"
+	ActionTypeNODE                 ActionType = "NODE"
+	ActionTypeSET_VARIABLE         ActionType = "SET_VARIABLE"
+	ActionTypeSET_VARIABLE_MODE    ActionType = "SET_VARIABLE_MODE"
+	ActionTypeCONDITIONAL          ActionType = "CONDITIONAL"
+	ActionTypeUPDATE_MEDIA_RUNTIME ActionType = "UPDATE_MEDIA_RUNTIME"
+)
+
+// Navigation is how a NODE action moves to its destination.
+type Navigation string
+
+const (
+	NavigationNAVIGATE  Navigation = "NAVIGATE"
+	NavigationSWAP      Navigation = "SWAP"
+	NavigationOVERLAY   Navigation = "OVERLAY"
+	NavigationSCROLL_TO Navigation = "SCROLL_TO"
+	NavigationCHANGE_TO Navigation = "CHANGE_TO"
+)
+
+// Action is the result of a prototype interaction. Which fields are set depends on Type.
+type Action struct {
+	Type ActionType `json:"type"`
+	// Destination of URL actions.
+	URL string `json:"url,omitempty"`
+	// Whether URL actions open in a new tab.
+	OpenInNewTab bool `json:"openInNewTab,omitempty"`
+	// ID of the destination node of NODE actions, or null.
+	DestinationID *string `json:"destinationId,omitempty"`
+	// How NODE actions navigate.
+	Navigation Navigation `json:"navigation,omitempty"`
+	// The animation used by NODE actions, or null for an instant transition.
+	Transition *Transition `json:"transition,omitempty"`
+	// Whether NODE actions keep the scroll position.
+	PreserveScrollPosition bool `json:"preserveScrollPosition,omitempty"`
+	// Where OVERLAY actions place the overlay, relative to the triggering node.
+	OverlayRelativePosition *Vector `json:"overlayRelativePosition,omitempty"`
+	// Whether NODE actions reset video positions.
+	ResetVideoPosition bool `json:"resetVideoPosition,omitempty"`
+	// Whether NODE actions reset scroll positions.
+	ResetScrollPosition bool `json:"resetScrollPosition,omitempty"`
+	// Whether NODE actions reset interactive components.
+	ResetInteractiveComponents bool `json:"resetInteractiveComponents,omitempty"`
+}
+
+// Destination returns the destination node ID of a NODE action, or "".
+func (a *Action) Destination() string {
+	if a == nil || a.DestinationID == nil {
+		return ""
+	}
+	return *a.DestinationID
+}
+
+// TransitionType is the animation of a prototype transition.
+type TransitionType string
+
+const (
+	TransitionTypeDISSOLVE       TransitionType = "DISSOLVE"
+	TransitionTypeSMART_ANIMATE  TransitionType = "SMART_ANIMATE"
+	TransitionTypeSCROLL_ANIMATE TransitionType = "SCROLL_ANIMATE"
+	TransitionTypeMOVE_IN        TransitionType = "MOVE_IN"
+	TransitionTypeMOVE_OUT       TransitionType = "MOVE_OUT"
+	TransitionTypePUSH           TransitionType = "PUSH"
+	TransitionTypeSLIDE_IN       TransitionType = "SLIDE_IN"
+	TransitionTypeSLIDE_OUT      TransitionType = "SLIDE_OUT"
+)
+
+// EasingType is the timing curve of a transition.
+type EasingType string
+
+const (
+	EasingTypeLINEAR               EasingType = "LINEAR"
+	EasingTypeEASE_IN              EasingType = "EASE_IN"
+	EasingTypeEASE_OUT             EasingType = "EASE_OUT"
+	EasingTypeEASE_IN_AND_OUT      EasingType = "EASE_IN_AND_OUT"
+	EasingTypeEASE_IN_BACK         EasingType = "EASE_IN_BACK"
+	EasingTypeEASE_OUT_BACK        EasingType = "EASE_OUT_BACK"
+	EasingTypeEASE_IN_AND_OUT_BACK EasingType = "EASE_IN_AND_OUT_BACK"
+	EasingTypeCUSTOM_CUBIC_BEZIER  EasingType = "CUSTOM_CUBIC_BEZIER"
+	EasingTypeGENTLE               EasingType = "GENTLE"
+	EasingTypeQUICK                EasingType = "QUICK"
+	EasingTypeBOUNCY               EasingType = "BOUNCY"
+	EasingTypeSLOW                 EasingType = "SLOW"
+	EasingTypeCUSTOM_SPRING        EasingType = "CUSTOM_SPRING"
+)
+
+// Easing is the timing curve of a transition.
+type Easing struct {
+	Type EasingType `json:"type"`
+	// Control points of CUSTOM_CUBIC_BEZIER curves.
+	EasingFunctionCubicBezier *struct {
+		X1 float64 `json:"x1"`
+		Y1 float64 `json:"y1"`
+		X2 float64 `json:"x2"`
+		Y2 float64 `json:"y2"`
+	} `json:"easingFunctionCubicBezier,omitempty"`
+	// Parameters of CUSTOM_SPRING curves.
+	EasingFunctionSpring *struct {
+		Mass      float64 `json:"mass"`
+		Stiffness float64 `json:"stiffness"`
+		Damping   float64 `json:"damping"`
+	} `json:"easingFunctionSpring,omitempty"`
+}
+
+// Transition is the animation between prototype screens.
+type Transition struct {
+	Type TransitionType `json:"type"`
+	// Duration in seconds.
+	Duration float64 `json:"duration,omitempty"`
+	Easing   *Easing `json:"easing,omitempty"`
+	// Direction of directional transitions: LEFT, RIGHT, TOP or BOTTOM.
+	Direction string `json:"direction,omitempty"`
+	// Whether layers with matching names animate between screens.
+	MatchLayers bool `json:"matchLayers,omitempty"`
+}
+
+// Interaction is a prototype trigger and the actions it performs.
+type Interaction struct {
+	// The trigger, or null if the interaction has none.
+	Trigger *Trigger `json:"trigger"`
+	// The actions performed, in order. Entries may be null.
+	Actions []*Action `json:"actions"`
+	// The single action of legacy reactions.
+	Action *Action `json:"action,omitempty"`
+}
+
+// AllActions returns the non-null actions of the interaction, including a legacy single action.
+func (i Interaction) AllActions() []*Action {
+	var result []*Action
+	if i.Action != nil {
+		result = append(result, i.Action)
+	}
+	for _, a := range i.Actions {
+		if a != nil {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// FlowStartingPoint is the first screen of a prototype flow.
+type FlowStartingPoint struct {
+	// ID of the starting screen.
+	NodeID string `json:"nodeId"`
+	// Name of the flow.
+	Name string `json:"name"`
+}
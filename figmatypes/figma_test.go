@@ -0,0 +1,59 @@
+package figmatypes
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestPaintOpacity(t *testing.T) {
+	tests := []struct {
+		in   string
+		want float64
+	}{
+		{`{"type": "SOLID"}`, 1},
+		{`{"type": "SOLID", "opacity": 0.25}`, 0.25},
+		// Fully transparent paints are not opaque.
+		{`{"type": "SOLID", "opacity": 0}`, 0},
+	}
+	for _, tt := range tests {
+		var p Paint
+		if err := json.Unmarshal([]byte(tt.in), &p); err != nil {
+			t.Fatal(err)
+		}
+		if got := p.GetOpacity(); got != tt.want {
+			t.Errorf("%s: GetOpacity() = %v, want %v", tt.in, got, tt.want)
+		}
+		out, err := json.Marshal(p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var again Paint
+		if err := json.Unmarshal(out, &again); err != nil || again.GetOpacity() != tt.want {
+			t.Errorf("%s: encoded as %s", tt.in, out)
+		}
+	}
+}
+
+func TestTypeStyleClone(t *testing.T) {
+	var s TypeStyle
+	if err := json.Unmarshal([]byte(`{"fontSize": 12, "letterSpacing": 0, "fills": [{"type": "SOLID", "color": {"r": 1, "g": 0, "b": 0, "a": 1}}]}`), &s); err != nil {
+		t.Fatal(err)
+	}
+	c := s.Clone()
+	if !c.Equal(s) {
+		t.Fatalf("clone %+v differs from %+v", c, s)
+	}
+	c.Fills[0].Color.R = 0
+	if s.Fills[0].Color.R != 1 {
+		t.Error("changing the clone's fill changed the original")
+	}
+	// The clone sets letterSpacing to 0 when merged, like the original.
+	base := TypeStyle{FontSize: 10, LetterSpacing: 2}
+	if got := base.Merge(s.Clone()); got.LetterSpacing != 0 || got.FontSize != 12 {
+		t.Errorf("merged clone = %+v, want letter spacing 0 and font size 12", got)
+	}
+	// Equal ignores which fields were decoded.
+	if !s.Equal(TypeStyle{FontSize: 12, Fills: s.Fills}) {
+		t.Error("decoded style differs from the equivalent built one")
+	}
+}
@@ -0,0 +1,158 @@
+package figmatypes
+
+import "math"
+
+// Transform is a 2D affine transform, stored as the top two rows of a 3x3 matrix:
+//
+//	[[a, c, tx],
+//	 [b, d, ty]]
+//
+// The bottom row is implicitly (0, 0, 1). A point (x, y) maps to (a*x + c*y + tx, b*x + d*y + ty).
+// The zero Transform is not the identity; see OrIdentity.
+type Transform [2][3]float64
+
+// IdentityTransform leaves points unchanged.
+var IdentityTransform = Transform{{1, 0, 0}, {0, 1, 0}}
+
+// TranslateTransform returns a transform that moves points by (tx, ty).
+func TranslateTransform(tx, ty float64) Transform {
+	return Transform{{1, 0, tx}, {0, 1, ty}}
+}
+
+// ScaleTransform returns a transform that scales points by (sx, sy) about the origin.
+func ScaleTransform(sx, sy float64) Transform {
+	return Transform{{sx, 0, 0}, {0, sy, 0}}
+}
+
+// RotateTransform returns a transform that rotates points by angle radians about the origin.
+// Positive angles rotate from the positive x axis towards the positive y axis, which is clockwise on screen.
+func RotateTransform(angle float64) Transform {
+	sin, cos := math.Sincos(angle)
+	return Transform{{cos, -sin, 0}, {sin, cos, 0}}
+}
+
+// SkewTransform returns a transform that skews points horizontally by angle radians.
+func SkewTransform(angle float64) Transform {
+	return Transform{{1, math.Tan(angle), 0}, {0, 1, 0}}
+}
+
+// IsZero reports whether t is the zero Transform, which stands for an absent transform.
+func (t Transform) IsZero() bool {
+	return t == Transform{}
+}
+
+// OrIdentity returns t, or the identity transform if t is zero.
+func (t Transform) OrIdentity() Transform {
+	if t.IsZero() {
+		return IdentityTransform
+	}
+	return t
+}
+
+// Multiply returns the product t × u: the transform that applies u and then t.
+func (t Transform) Multiply(u Transform) Transform {
+	return Transform{
+		{
+			t[0][0]*u[0][0] + t[0][1]*u[1][0],
+			t[0][0]*u[0][1] + t[0][1]*u[1][1],
+			t[0][0]*u[0][2] + t[0][1]*u[1][2] + t[0][2],
+		},
+		{
+			t[1][0]*u[0][0] + t[1][1]*u[1][0],
+			t[1][0]*u[0][1] + t[1][1]*u[1][1],
+			t[1][0]*u[0][2] + t[1][1]*u[1][2] + t[1][2],
+		},
+	}
+}
+
+// Determinant returns the determinant of the linear part of t.
+func (t Transform) Determinant() float64 {
+	return t[0][0]*t[1][1] - t[0][1]*t[1][0]
+}
+
+// Invert returns the inverse of t. It returns false if t is not invertible.
+func (t Transform) Invert() (Transform, bool) {
+	det := t.Determinant()
+	if det == 0 || math.IsNaN(det) {
+		return Transform{}, false
+	}
+	a, b, c, d := t[1][1]/det, -t[1][0]/det, -t[0][1]/det, t[0][0]/det
+	return Transform{
+		{a, c, -(a*t[0][2] + c*t[1][2])},
+		{b, d, -(b*t[0][2] + d*t[1][2])},
+	}, true
+}
+
+// Apply transforms a point.
+func (t Transform) Apply(p Vector) Vector {
+	return Vector{
+		X: t[0][0]*p.X + t[0][1]*p.Y + t[0][2],
+		Y: t[1][0]*p.X + t[1][1]*p.Y + t[1][2],
+	}
+}
+
+// ApplyVector transforms a direction, ignoring translation.
+func (t Transform) ApplyVector(v Vector) Vector {
+	return Vector{
+		X: t[0][0]*v.X + t[0][1]*v.Y,
+		Y: t[1][0]*v.X + t[1][1]*v.Y,
+	}
+}
+
+// ApplyRect transforms the corners of r and returns their axis-aligned bounding box.
+func (t Transform) ApplyRect(r Rectangle) Rectangle {
+	corners := [4]Vector{
+		t.Apply(Vector{r.X, r.Y}),
+		t.Apply(Vector{r.X + r.Width, r.Y}),
+		t.Apply(Vector{r.X, r.Y + r.Height}),
+		t.Apply(Vector{r.X + r.Width, r.Y + r.Height}),
+	}
+	minX, minY := corners[0].X, corners[0].Y
+	maxX, maxY := minX, minY
+	for _, c := range corners[1:] {
+		minX, maxX = math.Min(minX, c.X), math.Max(maxX, c.X)
+		minY, maxY = math.Min(minY, c.Y), math.Max(maxY, c.Y)
+	}
+	return Rectangle{X: minX, Y: minY, Width: maxX - minX, Height: maxY - minY}
+}
+
+// Decomposition is a transform broken into simple operations.
+// Compose applies them as translate × rotate × skew × scale.
+type Decomposition struct {
+	TranslateX, TranslateY float64
+	// Rotation in radians.
+	Rotation float64
+	// Horizontal skew in radians.
+	Skew           float64
+	ScaleX, ScaleY float64
+}
+
+// Decompose breaks t into translation, rotation, skew and scale.
+// A reflection is expressed as a negative ScaleY.
+func (t Transform) Decompose() Decomposition {
+	a, b, c, d := t[0][0], t[1][0], t[0][1], t[1][1]
+	result := Decomposition{TranslateX: t[0][2], TranslateY: t[1][2]}
+	sx := math.Hypot(a, b)
+	if sx == 0 {
+		result.ScaleY = math.Hypot(c, d)
+		return result
+	}
+	result.ScaleX = sx
+	result.Rotation = math.Atan2(b, a)
+	// after removing rotation, the linear part is [[sx, k], [0, sy]].
+	k := (a*c + b*d) / sx
+	sy := (a*d - b*c) / sx
+	result.ScaleY = sy
+	if sy != 0 {
+		result.Skew = math.Atan(k / sy)
+	}
+	return result
+}
+
+// Compose returns the transform described by d.
+func (d Decomposition) Compose() Transform {
+	return TranslateTransform(d.TranslateX, d.TranslateY).
+		Multiply(RotateTransform(d.Rotation)).
+		Multiply(SkewTransform(d.Skew)).
+		Multiply(ScaleTransform(d.ScaleX, d.ScaleY))
+}
@@ -0,0 +1,87 @@
+package figmatypes
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func approx(a, b Transform) bool {
+	for i := range a {
+		for j := range a[i] {
+			if math.Abs(a[i][j]-b[i][j]) > 1e-9 {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestTransform(t *testing.T) {
+	m := TranslateTransform(10, 20).Multiply(RotateTransform(math.Pi / 2)).Multiply(ScaleTransform(2, 3))
+	if got := m.Apply(Vector{1, 1}); math.Abs(got.X-7) > 1e-9 || math.Abs(got.Y-22) > 1e-9 {
+		t.Errorf("Apply = %v, want {7 22}", got)
+	}
+	inv, ok := m.Invert()
+	if !ok {
+		t.Fatal("not invertible")
+	}
+	if !approx(inv.Multiply(m), IdentityTransform) {
+		t.Errorf("inverse × m = %v", inv.Multiply(m))
+	}
+	if _, ok := ScaleTransform(0, 1).Invert(); ok {
+		t.Error("singular transform inverted")
+	}
+	r := RotateTransform(math.Pi / 4).ApplyRect(Rectangle{Width: 10, Height: 10})
+	if math.Abs(r.Width-10*math.Sqrt2) > 1e-9 || math.Abs(r.X+5*math.Sqrt2) > 1e-9 {
+		t.Errorf("ApplyRect = %+v", r)
+	}
+}
+
+func TestDecompose(t *testing.T) {
+	cases := []Transform{
+		IdentityTransform,
+		TranslateTransform(5, -3),
+		RotateTransform(1).Multiply(ScaleTransform(2, 0.5)),
+		TranslateTransform(1, 2).Multiply(RotateTransform(-2)).Multiply(SkewTransform(0.3)).Multiply(ScaleTransform(3, 4)),
+		ScaleTransform(1, -1),
+		{{0.7, -0.2, 4}, {0.4, 1.3, 9}},
+	}
+	for _, m := range cases {
+		if got := m.Decompose().Compose(); !approx(got, m) {
+			t.Errorf("Decompose(%v).Compose() = %v", m, got)
+		}
+	}
+	d := RotateTransform(0.5).Multiply(ScaleTransform(2, 3)).Decompose()
+	if math.Abs(d.Rotation-0.5) > 1e-9 || math.Abs(d.ScaleX-2) > 1e-9 || math.Abs(d.ScaleY-3) > 1e-9 || math.Abs(d.Skew) > 1e-9 {
+		t.Errorf("got %+v", d)
+	}
+}
+
+func TestTransformJSON(t *testing.T) {
+	var v struct {
+		T *Transform `json:"t,omitempty"`
+	}
+	if err := json.Unmarshal([]byte(`{"t": [[1, 0, 5], [0, 1, 6]]}`), &v); err != nil {
+		t.Fatal(err)
+	}
+	if v.T == nil || *v.T != TranslateTransform(5, 6) {
+		t.Errorf("got %v", v.T)
+	}
+	v.T = nil
+	b, _ := json.Marshal(v)
+	if string(b) != "{}" {
+		t.Errorf("absent transform encoded as %s", b)
+	}
+}
+
+func TestPaintImageTransformEncoding(t *testing.T) {
+	b, err := json.Marshal(Paint{Type: PaintTypeSOLID, Color: &Color{R: 1, A: 1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "imageTransform") {
+		t.Errorf("solid paint encoded an image transform: %s", b)
+	}
+}
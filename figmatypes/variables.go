@@ -0,0 +1,184 @@
+package figmatypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// VariableResolvedType is the type of the values of a variable.
+type VariableResolvedType string
+
+const (
+	VariableResolvedTypeBOOLEAN VariableResolvedType = "BOOLEAN"
+	VariableResolvedTypeFLOAT   VariableResolvedType = "FLOAT"
+	VariableResolvedTypeSTRING  VariableResolvedType = "STRING"
+	VariableResolvedTypeCOLOR   VariableResolvedType = "COLOR"
+)
+
+// Variable is a single design token, with a value for each mode of its collection.
+type Variable struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// The key used to import the variable from a library.
+	Key string `json:"key,omitempty"`
+	// The ID of the collection the variable belongs to.
+	VariableCollectionID string               `json:"variableCollectionId"`
+	ResolvedType         VariableResolvedType `json:"resolvedType"`
+	// The value of the variable in each mode of its collection, keyed by mode ID.
+	ValuesByMode map[string]VariableValue `json:"valuesByMode"`
+	// Whether the variable comes from a library.
+	Remote      bool   `json:"remote,omitempty"`
+	Description string `json:"description,omitempty"`
+	// Whether the variable is hidden from library consumers.
+	HiddenFromPublishing bool `json:"hiddenFromPublishing,omitempty"`
+	// The fields the variable may be bound to in the editor, such as "CORNER_RADIUS" or "ALL_SCOPES".
+	Scopes []string `json:"scopes,omitempty"`
+	// Names of the variable on each code platform: WEB, ANDROID and iOS.
+	CodeSyntax map[string]string `json:"codeSyntax,omitempty"`
+	// Whether the variable was deleted but is still used by nodes.
+	DeletedButReferenced bool `json:"deletedButReferenced,omitempty"`
+}
+
+// VariableMode is a mode of a variable collection, such as "Light" or "Dark".
+type VariableMode struct {
+	ModeID string `json:"modeId"`
+	Name   string `json:"name"`
+}
+
+// VariableCollection is a group of variables sharing a set of modes.
+type VariableCollection struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// The key used to import the collection from a library.
+	Key   string         `json:"key,omitempty"`
+	Modes []VariableMode `json:"modes"`
+	// The mode used where no mode is set explicitly.
+	DefaultModeID string `json:"defaultModeId"`
+	// Whether the collection comes from a library.
+	Remote bool `json:"remote,omitempty"`
+	// Whether the collection is hidden from library consumers.
+	HiddenFromPublishing bool `json:"hiddenFromPublishing,omitempty"`
+	// The IDs of the variables in the collection, in order.
+	VariableIDs []string `json:"variableIds,omitempty"`
+}
+
+// Mode returns the mode with the given ID or name.
+func (c VariableCollection) Mode(idOrName string) (VariableMode, bool) {
+	for _, m := range c.Modes {
+		if m.ModeID == idOrName || m.Name == idOrName {
+			return m, true
+		}
+	}
+	return VariableMode{}, false
+}
+
+// VariableAliasType is the type of every VariableAlias.
+const VariableAliasType = "VARIABLE_ALIAS"
+
+// VariableValue is the value of a variable in one mode. Exactly one field is set:
+// the value, of the variable's resolved type, or an alias to another variable.
+type VariableValue struct {
+	Bool   *bool
+	Float  *float64
+	String *string
+	Color  *Color
+	Alias  *VariableAlias
+}
+
+// IsZero reports whether no value is set.
+func (v VariableValue) IsZero() bool {
+	return v.Bool == nil && v.Float == nil && v.String == nil && v.Color == nil && v.Alias == nil
+}
+
+// Interface returns the value as a bool, float64, string, Color or VariableAlias, or nil if no value is set.
+func (v VariableValue) Interface() interface{} {
+	switch {
+	case v.Bool != nil:
+		return *v.Bool
+	case v.Float != nil:
+		return *v.Float
+	case v.String != nil:
+		return *v.String
+	case v.Color != nil:
+		return *v.Color
+	case v.Alias != nil:
+		return *v.Alias
+	}
+	return nil
+}
+
+// MarshalJSON encodes the value as a JSON bool, number, string or object.
+func (v VariableValue) MarshalJSON() ([]byte, error) {
+	return json.Marshal(v.Interface())
+}
+
+// UnmarshalJSON decodes a JSON bool, number, string, color object or alias object.
+func (v *VariableValue) UnmarshalJSON(data []byte) error {
+	*v = VariableValue{}
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 {
+		return fmt.Errorf("figmatypes: empty variable value")
+	}
+	switch data[0] {
+	case 't', 'f':
+		return json.Unmarshal(data, &v.Bool)
+	case '"':
+		return json.Unmarshal(data, &v.String)
+	case '{':
+		var probe struct {
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(data, &probe); err != nil {
+			return err
+		}
+		if probe.Type == VariableAliasType {
+			return json.Unmarshal(data, &v.Alias)
+		}
+		return json.Unmarshal(data, &v.Color)
+	case 'n':
+		return nil
+	}
+	return json.Unmarshal(data, &v.Float)
+}
+
+// VariableBinding is an entry of a node's boundVariables. Most fields are bound to a
+// single variable; fills and strokes are bound per paint, and component properties by name.
+type VariableBinding struct {
+	Alias *VariableAlias
+	// Aliases by index, for fields holding lists such as fills and strokes.
+	List []VariableAlias
+	// Nested bindings by name, for fields such as componentProperties.
+	Fields map[string]VariableBinding
+}
+
+// MarshalJSON encodes the binding as an alias object, an array or an object of bindings.
+func (b VariableBinding) MarshalJSON() ([]byte, error) {
+	switch {
+	case b.Alias != nil:
+		return json.Marshal(b.Alias)
+	case b.List != nil:
+		return json.Marshal(b.List)
+	}
+	return json.Marshal(b.Fields)
+}
+
+// UnmarshalJSON decodes an alias object, an array of aliases or an object of bindings.
+func (b *VariableBinding) UnmarshalJSON(data []byte) error {
+	*b = VariableBinding{}
+	data = bytes.TrimSpace(data)
+	if len(data) > 0 && data[0] == '[' {
+		b.List = []VariableAlias{}
+		return json.Unmarshal(data, &b.List)
+	}
+	var probe struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &probe); err != nil {
+		return err
+	}
+	if probe.Type == VariableAliasType {
+		return json.Unmarshal(data, &b.Alias)
+	}
+	return json.Unmarshal(data, &b.Fields)
+}
@@ -0,0 +1,3 @@
+// Package index provides fast lookups over the node tree of a Figma file:
+// nodes by ID, parents and ancestors, pages, siblings, component instances and style usage.
+package index
@@ -0,0 +1,143 @@
+package index
+
+import (
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// Index is a read-only index of a node tree. It is not updated if the tree changes.
+type Index struct {
+	root      nodes.Node
+	styles    map[string]figmatypes.Style
+	byID      map[string]nodes.Node
+	parents   map[string]nodes.Node
+	instances map[string][]*nodes.Instance
+	styleUses map[string][]nodes.Node
+}
+
+// New builds an index of the document of a file.
+func New(f *figma.File) *Index {
+	x := Build(&f.Document)
+	x.styles = f.Styles
+	return x
+}
+
+// Build builds an index of the tree rooted at root.
+func Build(root nodes.Node) *Index {
+	x := &Index{
+		root:      root,
+		byID:      make(map[string]nodes.Node),
+		parents:   make(map[string]nodes.Node),
+		instances: make(map[string][]*nodes.Instance),
+		styleUses: make(map[string][]nodes.Node),
+	}
+	for path, n := range nodes.All(root) {
+		id := n.GetID()
+		x.byID[id] = n
+		if p := path.Parent(); p != nil {
+			x.parents[id] = p
+		}
+		if i, ok := n.(*nodes.Instance); ok {
+			x.instances[i.ComponentID] = append(x.instances[i.ComponentID], i)
+		}
+		if s, ok := n.(nodes.Styled); ok {
+			seen := make(map[string]bool)
+			for _, styleID := range s.GetStyles() {
+				if !seen[styleID] {
+					seen[styleID] = true
+					x.styleUses[styleID] = append(x.styleUses[styleID], n)
+				}
+			}
+		}
+	}
+	return x
+}
+
+// Root returns the root of the indexed tree.
+func (x *Index) Root() nodes.Node {
+	return x.root
+}
+
+// Node returns the node with the given ID.
+func (x *Index) Node(id string) (nodes.Node, bool) {
+	n, ok := x.byID[id]
+	return n, ok
+}
+
+// Parent returns the parent of the node with the given ID, or nil for the root and unknown IDs.
+func (x *Index) Parent(id string) nodes.Node {
+	return x.parents[id]
+}
+
+// Ancestors returns the ancestors of the node with the given ID, from the root down to its parent.
+func (x *Index) Ancestors(id string) nodes.Path {
+	var result nodes.Path
+	for p := x.parents[id]; p != nil; p = x.parents[p.GetID()] {
+		result = append(result, p)
+	}
+	for i, j := 0, len(result)-1; i < j; i, j = i+1, j-1 {
+		result[i], result[j] = result[j], result[i]
+	}
+	return result
+}
+
+// Closest returns the nearest ancestor of the node with the given ID for which match returns true, or nil.
+func (x *Index) Closest(id string, match func(nodes.Node) bool) nodes.Node {
+	for p := x.parents[id]; p != nil; p = x.parents[p.GetID()] {
+		if match(p) {
+			return p
+		}
+	}
+	return nil
+}
+
+// Page returns the canvas containing the node with the given ID, or nil.
+// A canvas is its own page.
+func (x *Index) Page(id string) *nodes.Canvas {
+	if c, ok := x.byID[id].(*nodes.Canvas); ok {
+		return c
+	}
+	c, _ := x.Closest(id, func(n nodes.Node) bool {
+		_, ok := n.(*nodes.Canvas)
+		return ok
+	}).(*nodes.Canvas)
+	return c
+}
+
+// Siblings returns the other children of the parent of the node with the given ID, in order.
+func (x *Index) Siblings(id string) []nodes.Node {
+	p, ok := x.parents[id].(nodes.Parent)
+	if !ok {
+		return nil
+	}
+	var result []nodes.Node
+	for _, c := range p.GetChildren() {
+		if c.GetID() != id {
+			result = append(result, c)
+		}
+	}
+	return result
+}
+
+// Instances returns every instance of the component with the given ID, in document order.
+func (x *Index) Instances(componentID string) []*nodes.Instance {
+	return x.instances[componentID]
+}
+
+// Component returns the component node with the given ID, if it is part of the indexed tree.
+func (x *Index) Component(id string) (*nodes.Component, bool) {
+	c, ok := x.byID[id].(*nodes.Component)
+	return c, ok
+}
+
+// StyleUsers returns every node referencing the style with the given ID, in document order.
+func (x *Index) StyleUsers(styleID string) []nodes.Node {
+	return x.styleUses[styleID]
+}
+
+// Style returns the metadata of the style with the given ID. It is only available for indexes built with New.
+func (x *Index) Style(styleID string) (figmatypes.Style, bool) {
+	s, ok := x.styles[styleID]
+	return s, ok
+}
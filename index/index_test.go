@@ -0,0 +1,55 @@
+package index
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"testing"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/nodes"
+)
+
+func TestIndex(t *testing.T) {
+	buf, err := ioutil.ReadFile("../testdata/roundtrip/design-system.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &figma.File{}
+	if err := json.Unmarshal(buf, f); err != nil {
+		t.Fatal(err)
+	}
+	x := New(f)
+
+	if n, ok := x.Node("1:3"); !ok || n.GetName() != "Label" {
+		t.Fatalf("Node(1:3) = %v, %v", n, ok)
+	}
+	if p := x.Parent("1:3"); p == nil || p.GetID() != "1:2" {
+		t.Errorf("Parent(1:3) = %v", p)
+	}
+	var chain []string
+	for _, n := range x.Ancestors("1:3") {
+		chain = append(chain, n.GetID())
+	}
+	if got := len(chain); got != 3 || chain[0] != "0:0" || chain[2] != "1:2" {
+		t.Errorf("Ancestors(1:3) = %v", chain)
+	}
+	if p := x.Page("1:3"); p == nil || p.Name != "Components" {
+		t.Errorf("Page(1:3) = %v", p)
+	}
+	if s := x.Siblings("1:4"); len(s) != 2 || s[0].GetID() != "1:2" || s[1].GetID() != "1:5" {
+		t.Errorf("Siblings(1:4) = %v", s)
+	}
+	if i := x.Instances("1:2"); len(i) != 1 || i[0].ID != "1:4" {
+		t.Errorf("Instances(1:2) = %v", i)
+	}
+	if c, ok := x.Component("1:2"); !ok || c.Name != "Button" {
+		t.Errorf("Component(1:2) = %v, %v", c, ok)
+	}
+	users := x.StyleUsers("1:10")
+	if len(users) != 1 || users[0].GetType() != nodes.NodeTypeCOMPONENT {
+		t.Errorf("StyleUsers(1:10) = %v", users)
+	}
+	if s, ok := x.Style("1:10"); !ok || s.Name != "Brand/Primary" {
+		t.Errorf("Style(1:10) = %v, %v", s, ok)
+	}
+}
@@ -0,0 +1,725 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+type generator struct {
+	doc     *document
+	decls   map[string]string            // type name -> declaration
+	enums   map[string][]string          // enum type name -> values
+	fields  map[string]map[string]string // node name -> JSON name -> Go type
+	nodes   map[string]string            // node schema name -> type discriminator
+	unions  map[string]bool              // schema names whose members are all nodes
+	mixins  map[string]bool              // schema names only used through allOf
+	imports map[string]bool
+	err     error
+}
+
+func generate(doc *document, pkg string) ([]byte, error) {
+	g := &generator{
+		doc:     doc,
+		decls:   make(map[string]string),
+		enums:   make(map[string][]string),
+		fields:  make(map[string]map[string]string),
+		nodes:   make(map[string]string),
+		unions:  make(map[string]bool),
+		mixins:  make(map[string]bool),
+		imports: map[string]bool{"encoding/json": true, "fmt": true},
+	}
+	g.classify()
+
+	schemas := &doc.Components.Schemas
+	for _, name := range sorted(schemas.keys) {
+		g.declareComponent(name, schemas.get(name))
+	}
+	var ops bytes.Buffer
+	for _, path := range sorted(keys(doc.Paths)) {
+		for _, method := range sorted(keys(doc.Paths[path])) {
+			g.operation(&ops, path, doc.Paths[path][method])
+		}
+	}
+	if g.err != nil {
+		return nil, g.err
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by apigen. DO NOT EDIT.\n\npackage %s\n\nimport (\n", pkg)
+	for _, imp := range sorted(keys(g.imports)) {
+		fmt.Fprintf(&b, "\t%q\n", imp)
+	}
+	b.WriteString(")\n\n")
+	b.WriteString(nodeSupport)
+	g.nodeTypes(&b)
+	for _, name := range sorted(keys(g.decls)) {
+		b.WriteString(g.decls[name])
+	}
+	g.nodeMethods(&b)
+	b.Write(ops.Bytes())
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %v", err)
+	}
+	return src, nil
+}
+
+func (g *generator) failf(format string, args ...any) {
+	if g.err == nil {
+		g.err = fmt.Errorf(format, args...)
+	}
+}
+
+func (g *generator) schema(name string) *schema {
+	s := g.doc.Components.Schemas.get(name)
+	if s == nil {
+		g.failf("unknown schema %q", name)
+		return &schema{}
+	}
+	return s
+}
+
+// classify finds the node schemas, the unions of nodes and the schemas that
+// are only used as allOf mixins.
+func (g *generator) classify() {
+	schemas := &g.doc.Components.Schemas
+	used := make(map[string]bool)
+	var visit func(s *schema, mixin bool)
+	visit = func(s *schema, mixin bool) {
+		if s == nil {
+			return
+		}
+		if s.Ref != "" {
+			if name := refName(s.Ref); mixin {
+				g.mixins[name] = true
+			} else {
+				used[name] = true
+			}
+		}
+		for _, part := range s.AllOf {
+			visit(part, true)
+		}
+		for _, list := range [][]*schema{s.OneOf, s.AnyOf} {
+			for _, m := range list {
+				visit(m, false)
+			}
+		}
+		for _, p := range s.Properties.m {
+			visit(p, false)
+		}
+		visit(s.Items, false)
+		visit(s.AdditionalProperties, false)
+	}
+	for _, s := range schemas.m {
+		visit(s, false)
+	}
+	for _, item := range g.doc.Paths {
+		for _, op := range item {
+			for _, p := range op.Parameters {
+				visit(p.Schema, false)
+			}
+			for _, r := range op.Responses {
+				for _, c := range r.Content {
+					visit(c.Schema, false)
+				}
+			}
+		}
+	}
+	for name := range used {
+		delete(g.mixins, name)
+	}
+
+	for _, name := range schemas.keys {
+		if !strings.HasSuffix(name, "Node") {
+			continue
+		}
+		props, _, _ := g.flatten(name, schemas.get(name))
+		if t := props.get("type"); t != nil && len(t.Enum) == 1 {
+			g.nodes[name] = t.Enum[0]
+		}
+	}
+	for changed := true; changed; {
+		changed = false
+		for _, name := range schemas.keys {
+			if s := schemas.get(name); !g.unions[name] && g.isNodeUnion(s.OneOf) {
+				g.unions[name] = true
+				changed = true
+			}
+		}
+	}
+}
+
+func (g *generator) isNodeUnion(members []*schema) bool {
+	for _, m := range members {
+		if m.Ref == "" {
+			return false
+		}
+		if name := refName(m.Ref); g.nodes[name] == "" && !g.unions[name] {
+			return false
+		}
+	}
+	return len(members) > 0
+}
+
+// flatten merges the properties of s and of the schemas it composes with
+// allOf. Later definitions of a property replace earlier ones in place. The
+// returned owners map records the schema each property was declared in.
+func (g *generator) flatten(owner string, s *schema) (props *properties, required map[string]bool, owners map[string]string) {
+	props, required, owners = new(properties), make(map[string]bool), make(map[string]string)
+	var walk func(owner string, s *schema)
+	walk = func(owner string, s *schema) {
+		if s.Ref != "" {
+			name := refName(s.Ref)
+			walk(name, g.schema(name))
+			return
+		}
+		for _, part := range s.AllOf {
+			walk(owner, part)
+		}
+		for _, k := range s.Properties.keys {
+			props.set(k, s.Properties.get(k))
+			owners[k] = owner
+		}
+		for _, r := range s.Required {
+			required[r] = true
+		}
+	}
+	walk(owner, s)
+	return props, required, owners
+}
+
+func (g *generator) declareComponent(name string, s *schema) {
+	switch {
+	case g.unions[name]:
+		// Represented by Node.
+	case g.mixins[name]:
+		// Flattened into the schemas that compose it.
+	case len(s.AllOf) > 0 || len(s.Properties.keys) > 0:
+		g.declareStruct(name, s)
+	case s.Type == "string" && len(s.Enum) > 0:
+		g.declareEnum(name, s)
+	default:
+		t := g.typeOf(s, name)
+		var b strings.Builder
+		writeDoc(&b, "", name, s.Description)
+		if t == "json.RawMessage" {
+			fmt.Fprintf(&b, "type %s = %s\n\n", name, t)
+		} else {
+			fmt.Fprintf(&b, "type %s %s\n\n", name, t)
+		}
+		g.decls[name] = b.String()
+	}
+}
+
+// typeOf returns the Go type for s, declaring named types for inline
+// objects and enums under the name ctx.
+func (g *generator) typeOf(s *schema, ctx string) string {
+	switch {
+	case s.Ref != "":
+		name := refName(s.Ref)
+		if g.unions[name] {
+			return "AnyNode"
+		}
+		g.schema(name)
+		return name
+	case len(s.OneOf) > 0 || len(s.AnyOf) > 0:
+		if g.isNodeUnion(s.OneOf) {
+			return "AnyNode"
+		}
+		return "json.RawMessage"
+	case len(s.AllOf) > 0 || len(s.Properties.keys) > 0:
+		g.declareStruct(ctx, s)
+		return ctx
+	}
+	switch s.Type {
+	case "string":
+		if len(s.Enum) > 0 {
+			return g.declareEnum(ctx, s)
+		}
+		return "string"
+	case "number":
+		return "float64"
+	case "integer":
+		return "int"
+	case "boolean":
+		return "bool"
+	case "array":
+		if s.Items != nil {
+			t := g.typeOf(s.Items, singular(ctx))
+			if t == "AnyNode" {
+				return "Children"
+			}
+			return "[]" + t
+		}
+	case "object":
+		if s.AdditionalProperties != nil {
+			return "map[string]" + g.typeOf(s.AdditionalProperties, singular(ctx))
+		}
+	}
+	return "json.RawMessage"
+}
+
+// fieldType returns the Go type of the property prop declared in owner.
+// Inline enums are named after the property alone when that is unambiguous,
+// so traits shared by several nodes share their enum types.
+func (g *generator) fieldType(owner, prop string, s *schema) string {
+	qualified := exported(owner) + exported(prop)
+	if s.Ref != "" || s.Type != "string" || len(s.Enum) == 0 {
+		return g.typeOf(s, qualified)
+	}
+	name := exported(prop)
+	if vals, ok := g.enums[name]; prop == "type" || g.doc.Components.Schemas.get(name) != nil ||
+		(ok && !slices.Equal(vals, s.Enum)) || (!ok && g.decls[name] != "") {
+		name = qualified
+	}
+	return g.declareEnum(name, s)
+}
+
+// isObject reports whether s describes a struct that is not a node.
+func (g *generator) isObject(s *schema) bool {
+	if s.Ref != "" {
+		name := refName(s.Ref)
+		return g.nodes[name] == "" && !g.unions[name] && g.isObject(g.schema(name))
+	}
+	return len(s.AllOf) > 0 || len(s.Properties.keys) > 0
+}
+
+func (g *generator) declareStruct(name string, s *schema) {
+	if _, ok := g.decls[name]; ok {
+		return
+	}
+	g.decls[name] = "" // reserved while the fields are generated
+	props, required, owners := g.flatten(name, s)
+	node := g.nodes[name] != ""
+	if node {
+		g.fields[name] = make(map[string]string)
+	}
+	var b strings.Builder
+	writeDoc(&b, "", name, s.Description)
+	fmt.Fprintf(&b, "type %s struct {\n", name)
+	for _, k := range props.keys {
+		p := props.get(k)
+		var t string
+		if node && k == "type" {
+			t = "NodeType"
+		} else {
+			t = g.fieldType(owners[k], k, p)
+		}
+		tag := k
+		if !required[k] {
+			tag += ",omitempty"
+			if t == "bool" || t == "int" || t == "float64" || g.isObject(p) {
+				t = "*" + t
+			}
+		}
+		if node {
+			g.fields[name][k] = t
+		}
+		writeDoc(&b, "\t", "", p.Description)
+		fmt.Fprintf(&b, "\t%s %s `json:%q`\n", exported(k), t, tag)
+	}
+	b.WriteString("}\n\n")
+	g.decls[name] = b.String()
+}
+
+func (g *generator) declareEnum(name string, s *schema) string {
+	if vals, ok := g.enums[name]; ok {
+		if !slices.Equal(vals, s.Enum) {
+			g.failf("enum %s declared with different values", name)
+		}
+		return name
+	}
+	g.enums[name] = s.Enum
+	var b strings.Builder
+	writeDoc(&b, "", name, s.Description)
+	fmt.Fprintf(&b, "type %s string\n\n", name)
+	writeConsts(&b, name, s.Enum)
+	g.decls[name] = b.String()
+	return name
+}
+
+func writeConsts(b *strings.Builder, typ string, values []string) {
+	b.WriteString("const (\n")
+	for _, v := range values {
+		fmt.Fprintf(b, "\t%s %s = %q\n", constName(typ, v), typ, v)
+	}
+	b.WriteString(")\n\n")
+}
+
+// nodeTypes writes the NodeType enum and New.
+func (g *generator) nodeTypes(b *bytes.Buffer) {
+	names := sorted(keys(g.nodes))
+	var values []string
+	for _, name := range names {
+		values = append(values, g.nodes[name])
+	}
+	var sb strings.Builder
+	sb.WriteString("// NodeType is the type of a node.\ntype NodeType string\n\n")
+	writeConsts(&sb, "NodeType", values)
+	sb.WriteString("// New returns an empty node of type t, or nil if t is not a known node type.\nfunc New(t NodeType) Node {\n\tswitch t {\n")
+	for _, name := range names {
+		fmt.Fprintf(&sb, "\tcase %s:\n\t\treturn &%s{Type: t}\n", constName("NodeType", g.nodes[name]), name)
+	}
+	sb.WriteString("\t}\n\treturn nil\n}\n\n")
+	b.WriteString(sb.String())
+}
+
+// nodeMethods writes the Node and Parent methods of every node struct.
+func (g *generator) nodeMethods(b *bytes.Buffer) {
+	for _, name := range sorted(keys(g.nodes)) {
+		fields := g.fields[name]
+		fmt.Fprintf(b, "// GetID returns the ID of the node.\nfunc (n *%s) GetID() string { return n.ID }\n\n", name)
+		fmt.Fprintf(b, "// GetName returns the name of the node.\nfunc (n *%s) GetName() string { return n.Name }\n\n", name)
+		fmt.Fprintf(b, "// GetType returns the type of the node.\nfunc (n *%s) GetType() NodeType { return n.Type }\n\n", name)
+		visible := "nil"
+		if fields["visible"] == "*bool" {
+			visible = "n.Visible"
+		}
+		fmt.Fprintf(b, "// GetVisible reports whether the node is visible, or nil if unset.\nfunc (n *%s) GetVisible() *bool { return %s }\n\n", name, visible)
+		switch t := fields["children"]; {
+		case t == "Children":
+			fmt.Fprintf(b, "// GetChildren returns the children of the node.\nfunc (n *%s) GetChildren() Children { return n.Children }\n\n", name)
+		case strings.HasPrefix(t, "[]") && g.nodes[t[2:]] != "":
+			fmt.Fprintf(b, "// GetChildren returns the children of the node.\nfunc (n *%s) GetChildren() Children {\n"+
+				"\tchildren := make(Children, len(n.Children))\n"+
+				"\tfor i := range n.Children {\n\t\tchildren[i] = &n.Children[i]\n\t}\n"+
+				"\treturn children\n}\n\n", name)
+		}
+	}
+}
+
+// operation writes the Params and Response types of op.
+func (g *generator) operation(b *bytes.Buffer, path string, op *operation) {
+	if op.OperationID == "" {
+		g.failf("%s: missing operationId", path)
+		return
+	}
+	name := exported(op.OperationID)
+	params := name + "Params"
+	type param struct {
+		*parameter
+		field, typ string
+	}
+	var ps []param
+	for _, p := range op.Parameters {
+		if p.Ref != "" {
+			ref := g.doc.Components.Parameters[refName(p.Ref)]
+			if ref == nil {
+				g.failf("%s: unknown parameter %q", path, p.Ref)
+				return
+			}
+			p = ref
+		}
+		if p.In != "path" && p.In != "query" {
+			continue
+		}
+		s := p.Schema
+		if s == nil {
+			s = &schema{Type: "string"}
+		}
+		field := exported(p.Name)
+		var t string
+		if s.Ref == "" && s.Type == "string" && len(s.Enum) > 0 {
+			// Parameter enums are scoped to their operation.
+			t = g.declareEnum(name+field, s)
+		} else {
+			t = g.typeOf(s, name+field)
+		}
+		if !p.Required && (t == "bool" || t == "int" || t == "float64") {
+			t = "*" + t
+		}
+		ps = append(ps, param{p, field, t})
+	}
+
+	var sb strings.Builder
+	writeDoc(&sb, "", "", fmt.Sprintf("%s holds the parameters of the %s operation (GET %s).", params, op.OperationID, path))
+	fmt.Fprintf(&sb, "type %s struct {\n", params)
+	for _, p := range ps {
+		writeDoc(&sb, "\t", "", p.Description)
+		fmt.Fprintf(&sb, "\t%s %s\n", p.field, p.typ)
+	}
+	sb.WriteString("}\n\n")
+
+	g.imports["net/url"] = true
+	fmt.Fprintf(&sb, "// Path returns the request path of the %s operation.\nfunc (p *%s) Path() string {\n\treturn ", op.OperationID, params)
+	var parts []string
+	for rest := path; rest != ""; {
+		i := strings.IndexByte(rest, '{')
+		if i < 0 {
+			parts = append(parts, fmt.Sprintf("%q", rest))
+			break
+		}
+		j := strings.IndexByte(rest, '}')
+		if i > 0 {
+			parts = append(parts, fmt.Sprintf("%q", rest[:i]))
+		}
+		parts = append(parts, fmt.Sprintf("url.PathEscape(p.%s)", exported(rest[i+1:j])))
+		rest = rest[j+1:]
+	}
+	sb.WriteString(strings.Join(parts, " + "))
+	sb.WriteString("\n}\n\n")
+
+	fmt.Fprintf(&sb, "// Query returns the query parameters of the %s operation.\nfunc (p *%s) Query() url.Values {\n\tv := make(url.Values)\n", op.OperationID, params)
+	for _, p := range ps {
+		if p.In != "query" {
+			continue
+		}
+		x := "p." + p.field
+		base := strings.TrimPrefix(p.typ, "*")
+		if base != p.typ {
+			x = "*" + x
+		}
+		var value string
+		switch base {
+		case "string":
+			value = x
+		case "int":
+			value = "strconv.Itoa(" + x + ")"
+		case "float64":
+			value = "strconv.FormatFloat(" + x + ", 'g', -1, 64)"
+		case "bool":
+			value = "strconv.FormatBool(" + x + ")"
+		default:
+			value = "string(" + x + ")"
+		}
+		if base == "int" || base == "float64" || base == "bool" {
+			g.imports["strconv"] = true
+		}
+		set := fmt.Sprintf("v.Set(%q, %s)\n", p.Name, value)
+		switch {
+		case base != p.typ:
+			fmt.Fprintf(&sb, "\tif p.%s != nil {\n\t\t%s\t}\n", p.field, set)
+		case base == "string" || g.enums[base] != nil:
+			fmt.Fprintf(&sb, "\tif p.%s != \"\" {\n\t\t%s\t}\n", p.field, set)
+		default:
+			sb.WriteString("\t" + set)
+		}
+	}
+	sb.WriteString("\treturn v\n}\n\n")
+
+	if r := op.Responses["200"]; r != nil {
+		if c, ok := r.Content["application/json"]; ok && c.Schema != nil {
+			resp := name + "Response"
+			s := c.Schema
+			if s.Description == "" {
+				s.Description = r.Description
+			}
+			if t := g.typeOf(s, resp); t != resp {
+				fmt.Fprintf(&sb, "// %s is the response of the %s operation.\ntype %s = %s\n\n", resp, op.OperationID, resp, t)
+			}
+		}
+	}
+	b.WriteString(sb.String())
+}
+
+// writeDoc writes text as a comment. The comment of a declaration, for which
+// name is set, starts with the name and is never empty.
+func writeDoc(b *strings.Builder, indent, name, text string) {
+	text = strings.TrimSpace(text)
+	switch {
+	case name != "" && text == "":
+		text = name + " is generated from the API specification."
+	case name != "":
+		text = name + ": " + text
+	case text == "":
+		return
+	}
+	line := indent + "//"
+	for _, word := range strings.Fields(text) {
+		if len(line)+1+len(word) > 78 && len(line) > len(indent)+2 {
+			b.WriteString(line + "\n")
+			line = indent + "//"
+		}
+		line += " " + word
+	}
+	b.WriteString(line + "\n")
+}
+
+var initialisms = map[string]string{
+	"id":   "ID",
+	"ids":  "IDs",
+	"url":  "URL",
+	"urls": "URLs",
+	"svg":  "SVG",
+	"json": "JSON",
+	"html": "HTML",
+	"css":  "CSS",
+	"pdf":  "PDF",
+}
+
+// exported converts a camelCase or snake_case JSON name to an exported Go
+// identifier.
+func exported(s string) string {
+	var b strings.Builder
+	word := func(w string) {
+		if w == "" {
+			return
+		}
+		if init, ok := initialisms[strings.ToLower(w)]; ok {
+			b.WriteString(init)
+			return
+		}
+		r := []rune(w)
+		r[0] = unicode.ToUpper(r[0])
+		b.WriteString(string(r))
+	}
+	r := []rune(s)
+	start := 0
+	for i := 0; i < len(r); i++ {
+		switch {
+		case r[i] == '_' || r[i] == '-' || r[i] == ' ' || r[i] == '.':
+			word(string(r[start:i]))
+			start = i + 1
+		case i > start && unicode.IsUpper(r[i]) && !unicode.IsUpper(r[i-1]):
+			word(string(r[start:i]))
+			start = i
+		}
+	}
+	word(string(r[start:]))
+	return b.String()
+}
+
+// constName returns the name of the constant for value in the enum typ,
+// following the TypeNameVALUE convention.
+func constName(typ, value string) string {
+	return typ + strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToUpper(r)
+		}
+		return '_'
+	}, value)
+}
+
+// singular returns the name used for the elements of a list or map named s.
+func singular(s string) string {
+	switch {
+	case strings.HasSuffix(s, "ies"):
+		return s[:len(s)-3] + "y"
+	case strings.HasSuffix(s, "ss"), strings.HasSuffix(s, "us"):
+		return s
+	case strings.HasSuffix(s, "s"):
+		return s[:len(s)-1]
+	}
+	return s + "Item"
+}
+
+func keys[V any](m map[string]V) []string {
+	ks := make([]string, 0, len(m))
+	for k := range m {
+		ks = append(ks, k)
+	}
+	return ks
+}
+
+func sorted(s []string) []string {
+	s = slices.Clone(s)
+	sort.Strings(s)
+	return s
+}
+
+const nodeSupport = `// Node is implemented by every node type.
+type Node interface {
+	GetID() string
+	GetName() string
+	GetType() NodeType
+	GetVisible() *bool
+}
+
+// Parent is implemented by nodes that have children.
+type Parent interface {
+	Node
+	GetChildren() Children
+}
+
+// Children is a list of nodes of any type. It decodes each node into the
+// struct for its type.
+type Children []Node
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Children) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	children := make(Children, 0, len(raw))
+	for _, r := range raw {
+		n, err := unmarshalNode(r)
+		if err != nil {
+			return err
+		}
+		children = append(children, n)
+	}
+	*c = children
+	return nil
+}
+
+// AnyNode holds a single node of any type.
+type AnyNode struct {
+	Node
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AnyNode) UnmarshalJSON(data []byte) (err error) {
+	a.Node, err = unmarshalNode(data)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a AnyNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Node)
+}
+
+// UnknownNode holds a node of a type that is not in the specification. It
+// encodes back to the JSON it was decoded from.
+type UnknownNode struct {
+	ID      string          ` + "`json:\"id\"`" + `
+	Name    string          ` + "`json:\"name\"`" + `
+	Type    NodeType        ` + "`json:\"type\"`" + `
+	Visible *bool           ` + "`json:\"visible,omitempty\"`" + `
+	Raw     json.RawMessage ` + "`json:\"-\"`" + `
+}
+
+// GetID returns the ID of the node.
+func (n *UnknownNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *UnknownNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *UnknownNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *UnknownNode) GetVisible() *bool { return n.Visible }
+
+// MarshalJSON implements json.Marshaler.
+func (n *UnknownNode) MarshalJSON() ([]byte, error) {
+	if n.Raw != nil {
+		return n.Raw, nil
+	}
+	type plain UnknownNode
+	return json.Marshal((*plain)(n))
+}
+
+func unmarshalNode(data []byte) (Node, error) {
+	var head struct {
+		Type NodeType ` + "`json:\"type\"`" + `
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+	n := New(head.Type)
+	if n == nil {
+		n = &UnknownNode{Raw: append(json.RawMessage(nil), data...)}
+	}
+	if err := json.Unmarshal(data, n); err != nil {
+		return nil, fmt.Errorf("decoding %s node: %w", head.Type, err)
+	}
+	return n, nil
+}
+
+`
@@ -0,0 +1,74 @@
+// Command apigen generates Go types from Figma's REST API specification.
+//
+// It reads an OpenAPI 3 document in JSON form and writes a single Go file
+// containing:
+//
+//   - a struct for every node schema (a schema named *Node whose type
+//     property is a single-valued enum), with the node traits referenced
+//     through allOf flattened into it;
+//   - the Node and Parent interfaces, a NodeType enum built from the node
+//     discriminators, New, and a Children type that decodes a list of nodes
+//     by their type;
+//   - a struct or named type for every other component schema, and a string
+//     type with constants for every enum;
+//   - a Params struct with Path and Query methods for every operation, and a
+//     Response type for its 200 response.
+//
+// A oneOf whose members are all nodes becomes Node (Children in lists); any
+// other oneOf is kept as json.RawMessage. Enum methods are left to enumgen.
+//
+// Usage, from a go:generate directive:
+//
+//	//go:generate go run ../internal/cmd/apigen -spec ../third_party/figma-rest-api-spec/openapi.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+)
+
+func main() {
+	spec := flag.String("spec", "", "path of the OpenAPI document")
+	pkg := flag.String("package", "", "package name (default: name of the current directory)")
+	output := flag.String("output", "api_gen.go", "output file name")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("apigen: ")
+
+	if *spec == "" {
+		log.Fatal("-spec is required")
+	}
+	if *pkg == "" {
+		wd, err := os.Getwd()
+		if err != nil {
+			log.Fatal(err)
+		}
+		*pkg = filepath.Base(wd)
+	}
+	doc, err := load(*spec)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(doc, *pkg)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func load(path string) (*document, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	doc := new(document)
+	if err := json.Unmarshal(data, doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
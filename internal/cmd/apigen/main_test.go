@@ -0,0 +1,42 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"testing"
+)
+
+func TestGeneratedUpToDate(t *testing.T) {
+	doc, err := load("../../../third_party/figma-rest-api-spec/openapi.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(doc, "restapi")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := os.ReadFile("../../../restapi/api_gen.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Error("restapi/api_gen.go is out of date; run go generate ./restapi")
+	}
+}
+
+func TestExported(t *testing.T) {
+	tests := map[string]string{
+		"id":                   "ID",
+		"file_key":             "FileKey",
+		"thumbnailUrl":         "ThumbnailURL",
+		"svg_include_id":       "SVGIncludeID",
+		"prototypeStartNodeID": "PrototypeStartNodeID",
+		"variableIds":          "VariableIDs",
+		"RGBA":                 "RGBA",
+	}
+	for in, want := range tests {
+		if got := exported(in); got != want {
+			t.Errorf("exported(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
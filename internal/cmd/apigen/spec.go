@@ -0,0 +1,151 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// document is the subset of an OpenAPI 3 document that apigen reads.
+type document struct {
+	Paths      map[string]map[string]*operation `json:"paths"`
+	Components struct {
+		Schemas    properties            `json:"schemas"`
+		Parameters map[string]*parameter `json:"parameters"`
+	} `json:"components"`
+}
+
+type operation struct {
+	OperationID string               `json:"operationId"`
+	Description string               `json:"description"`
+	Parameters  []*parameter         `json:"parameters"`
+	Responses   map[string]*response `json:"responses"`
+}
+
+type parameter struct {
+	Ref         string  `json:"$ref"`
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Required    bool    `json:"required"`
+	Description string  `json:"description"`
+	Schema      *schema `json:"schema"`
+}
+
+type response struct {
+	Description string `json:"description"`
+	Content     map[string]struct {
+		Schema *schema `json:"schema"`
+	} `json:"content"`
+}
+
+type schema struct {
+	Ref                  string     `json:"$ref"`
+	Type                 string     `json:"-"`
+	Nullable             bool       `json:"nullable"`
+	Format               string     `json:"format"`
+	Description          string     `json:"description"`
+	Enum                 []string   `json:"enum"`
+	Properties           properties `json:"properties"`
+	Required             []string   `json:"required"`
+	Items                *schema    `json:"items"`
+	AllOf                []*schema  `json:"allOf"`
+	OneOf                []*schema  `json:"oneOf"`
+	AnyOf                []*schema  `json:"anyOf"`
+	AdditionalProperties *schema    `json:"-"`
+	Discriminator        *struct {
+		PropertyName string `json:"propertyName"`
+	} `json:"discriminator"`
+}
+
+// UnmarshalJSON accepts both the OpenAPI 3.0 and 3.1 spellings of type and
+// nullability, and a boolean additionalProperties.
+func (s *schema) UnmarshalJSON(data []byte) error {
+	type plain schema
+	aux := struct {
+		*plain
+		Type                 json.RawMessage `json:"type"`
+		AdditionalProperties json.RawMessage `json:"additionalProperties"`
+	}{plain: (*plain)(s)}
+	if err := json.Unmarshal(data, &aux); err != nil {
+		return err
+	}
+	switch t := bytes.TrimSpace(aux.Type); {
+	case len(t) == 0:
+	case t[0] == '[':
+		var types []string
+		if err := json.Unmarshal(t, &types); err != nil {
+			return err
+		}
+		for _, typ := range types {
+			if typ == "null" {
+				s.Nullable = true
+			} else {
+				s.Type = typ
+			}
+		}
+	default:
+		if err := json.Unmarshal(t, &s.Type); err != nil {
+			return err
+		}
+	}
+	switch ap := bytes.TrimSpace(aux.AdditionalProperties); {
+	case len(ap) == 0, string(ap) == "false":
+	case string(ap) == "true":
+		s.AdditionalProperties = &schema{}
+	default:
+		s.AdditionalProperties = new(schema)
+		if err := json.Unmarshal(ap, s.AdditionalProperties); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// properties is a JSON object of schemas that remembers its key order, so
+// generated structs list fields in the order the specification does.
+type properties struct {
+	keys []string
+	m    map[string]*schema
+}
+
+func (p *properties) get(name string) *schema { return p.m[name] }
+
+func (p *properties) set(name string, s *schema) {
+	if p.m == nil {
+		p.m = make(map[string]*schema)
+	}
+	if _, ok := p.m[name]; !ok {
+		p.keys = append(p.keys, name)
+	}
+	p.m[name] = s
+}
+
+func (p *properties) UnmarshalJSON(data []byte) error {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok != json.Delim('{') {
+		return fmt.Errorf("properties: expected object, got %v", tok)
+	}
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		s := new(schema)
+		if err := dec.Decode(s); err != nil {
+			return err
+		}
+		p.set(tok.(string), s)
+	}
+	_, err = dec.Token()
+	return err
+}
+
+// refName returns the component name a local schema reference points at.
+func refName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
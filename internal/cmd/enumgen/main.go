@@ -0,0 +1,142 @@
+// Command enumgen generates methods for the string enum types of the package
+// in the current directory.
+//
+// For every named type whose underlying type is string and that has constants
+// declared with that type, it writes String, IsValid, MarshalText and
+// UnmarshalText methods and a function returning all values. Constants that
+// repeat an earlier value are treated as aliases.
+//
+// Usage, from a go:generate directive:
+//
+//	//go:generate go run ../internal/cmd/enumgen
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type enum struct {
+	name   string
+	consts []string
+}
+
+func main() {
+	output := flag.String("output", "enums_gen.go", "output file name")
+	flag.Parse()
+	log.SetFlags(0)
+	log.SetPrefix("enumgen: ")
+
+	pkg, enums, err := parse(".", *output)
+	if err != nil {
+		log.Fatal(err)
+	}
+	src, err := generate(pkg, enums)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*output, src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// parse returns the package name and the enums declared in the non-test Go files of dir.
+func parse(dir, output string) (string, []*enum, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return "", nil, err
+	}
+	sort.Strings(files)
+	fset := token.NewFileSet()
+	var (
+		pkg    string
+		order  []string
+		byName = make(map[string]*enum)
+		seen   = make(map[string]map[string]bool)
+		consts []*ast.ValueSpec
+	)
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") || filepath.Base(name) == output {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			return "", nil, err
+		}
+		pkg = f.Name.Name
+		for _, decl := range f.Decls {
+			d, ok := decl.(*ast.GenDecl)
+			if !ok {
+				continue
+			}
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					if id, ok := s.Type.(*ast.Ident); ok && id.Name == "string" && s.Assign == 0 {
+						order = append(order, s.Name.Name)
+						byName[s.Name.Name] = &enum{name: s.Name.Name}
+						seen[s.Name.Name] = make(map[string]bool)
+					}
+				case *ast.ValueSpec:
+					if d.Tok == token.CONST {
+						consts = append(consts, s)
+					}
+				}
+			}
+		}
+	}
+	for _, s := range consts {
+		typ, ok := s.Type.(*ast.Ident)
+		if !ok || byName[typ.Name] == nil || len(s.Names) != 1 || len(s.Values) != 1 {
+			continue
+		}
+		lit, ok := s.Values[0].(*ast.BasicLit)
+		if !ok || lit.Kind != token.STRING {
+			continue
+		}
+		value, err := strconv.Unquote(lit.Value)
+		if err != nil {
+			return "", nil, err
+		}
+		if seen[typ.Name][value] {
+			continue
+		}
+		seen[typ.Name][value] = true
+		e := byName[typ.Name]
+		e.consts = append(e.consts, s.Names[0].Name)
+	}
+	var enums []*enum
+	for _, name := range order {
+		if e := byName[name]; len(e.consts) > 0 {
+			enums = append(enums, e)
+		}
+	}
+	return pkg, enums, nil
+}
+
+func generate(pkg string, enums []*enum) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by enumgen. DO NOT EDIT.\n\npackage %s\n\n", pkg)
+	for _, e := range enums {
+		n := e.name
+		fmt.Fprintf(&b, "// String returns the value as a string.\nfunc (v %s) String() string { return string(v) }\n\n", n)
+		fmt.Fprintf(&b, "// IsValid reports whether v is a known %s.\nfunc (v %s) IsValid() bool {\n\tswitch v {\n\tcase %s:\n\t\treturn true\n\t}\n\treturn false\n}\n\n",
+			n, n, strings.Join(e.consts, ",\n\t\t"))
+		fmt.Fprintf(&b, "// MarshalText implements encoding.TextMarshaler. Unknown values are kept.\nfunc (v %s) MarshalText() ([]byte, error) { return []byte(v), nil }\n\n", n)
+		fmt.Fprintf(&b, "// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;\n// see figmatypes.Strict to reject them.\nfunc (v *%s) UnmarshalText(text []byte) error {\n\t*v = %s(text)\n\treturn nil\n}\n\n", n, n)
+		fmt.Fprintf(&b, "// %sValues returns the known %s values.\nfunc %sValues() []%s {\n\treturn []%s{\n\t\t%s,\n\t}\n}\n\n",
+			n, n, n, n, n, strings.Join(e.consts, ",\n\t\t"))
+	}
+	return format.Source(b.Bytes())
+}
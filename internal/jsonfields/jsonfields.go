@@ -0,0 +1,160 @@
+// Package jsonfields describes how Go struct types map to JSON object keys under encoding/json.
+package jsonfields
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// Field is a struct field as seen by encoding/json.
+type Field struct {
+	// Name is the JSON object key.
+	Name string
+	// Index is the field index sequence for reflect.Value.FieldByIndex.
+	Index []int
+	// Type is the Go type of the field.
+	Type reflect.Type
+	// OmitEmpty reports whether the field is tagged omitempty.
+	OmitEmpty bool
+}
+
+var cache sync.Map // map[reflect.Type]map[string]Field
+
+// Of returns the JSON fields of struct type t keyed by JSON name, following embedded structs.
+// Conflicting fields of the same name are resolved as in encoding/json: the shallowest wins,
+// and of several at that depth the only one with a JSON tag, if there is exactly one;
+// otherwise the name is dropped.
+// Pointers are dereferenced; non-struct types have no fields.
+func Of(t reflect.Type) map[string]Field {
+	t = Deref(t)
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+	if f, ok := cache.Load(t); ok {
+		return f.(map[string]Field)
+	}
+	fields := make(map[string]Field)
+	for name, cs := range collect(t) {
+		if f, ok := dominant(cs); ok {
+			fields[name] = f
+		}
+	}
+	cache.Store(t, fields)
+	return fields
+}
+
+// candidate is a field that may provide a JSON name.
+type candidate struct {
+	Field
+	tagged bool
+}
+
+// collect returns the candidate fields of t by name, walking embedded structs
+// breadth first as encoding/json does, so that each type is expanded at its
+// shallowest depth only.
+func collect(t reflect.Type) map[string][]candidate {
+	type embedded struct {
+		typ   reflect.Type
+		index []int
+	}
+	byName := make(map[string][]candidate)
+	visited := make(map[reflect.Type]bool)
+	next := []embedded{{typ: t}}
+	for len(next) > 0 {
+		current := next
+		next = nil
+		for _, e := range current {
+			if visited[e.typ] {
+				continue
+			}
+			visited[e.typ] = true
+			for i := 0; i < e.typ.NumField(); i++ {
+				sf := e.typ.Field(i)
+				if sf.Anonymous {
+					// Embedded unexported structs still contribute their exported fields.
+					if !sf.IsExported() && Deref(sf.Type).Kind() != reflect.Struct {
+						continue
+					}
+				} else if !sf.IsExported() {
+					continue
+				}
+				tag := sf.Tag.Get("json")
+				if tag == "-" {
+					continue
+				}
+				name, opts, _ := strings.Cut(tag, ",")
+				idx := append(append([]int(nil), e.index...), i)
+				ft := sf.Type
+				if ft.Name() == "" && ft.Kind() == reflect.Ptr {
+					ft = ft.Elem()
+				}
+				if name == "" && sf.Anonymous && ft.Kind() == reflect.Struct {
+					next = append(next, embedded{ft, idx})
+					continue
+				}
+				tagged := name != ""
+				if !tagged {
+					name = sf.Name
+				}
+				byName[name] = append(byName[name], candidate{
+					Field: Field{
+						Name:      name,
+						Index:     idx,
+						Type:      sf.Type,
+						OmitEmpty: hasOpt(opts, "omitempty"),
+					},
+					tagged: tagged,
+				})
+			}
+		}
+	}
+	return byName
+}
+
+// dominant returns the field encoding/json uses among fields of the same name:
+// the only one at the shallowest depth, or the only tagged one there.
+func dominant(cs []candidate) (Field, bool) {
+	depth := len(cs[0].Index)
+	for _, c := range cs[1:] {
+		if len(c.Index) < depth {
+			depth = len(c.Index)
+		}
+	}
+	var shallow, tagged []candidate
+	for _, c := range cs {
+		if len(c.Index) != depth {
+			continue
+		}
+		shallow = append(shallow, c)
+		if c.tagged {
+			tagged = append(tagged, c)
+		}
+	}
+	switch {
+	case len(shallow) == 1:
+		return shallow[0].Field, true
+	case len(tagged) == 1:
+		return tagged[0].Field, true
+	}
+	return Field{}, false
+}
+
+func hasOpt(opts, opt string) bool {
+	for opts != "" {
+		var o string
+		o, opts, _ = strings.Cut(opts, ",")
+		if o == opt {
+			return true
+		}
+	}
+	return false
+}
+
+// Deref returns the type pointed to by t, repeatedly.
+func Deref(t reflect.Type) reflect.Type {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	return t
+}
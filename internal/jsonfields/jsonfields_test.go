@@ -0,0 +1,63 @@
+package jsonfields
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+type inner struct {
+	A string
+	B string `json:"b"`
+	C string
+}
+
+type other struct {
+	A string
+	B string
+	C string `json:"C"`
+	D string
+}
+
+type deep struct {
+	D string
+	E string
+}
+
+type outer struct {
+	inner
+	*other
+	Deep deep `json:"-"`
+	deep
+	E string
+}
+
+func TestOfMatchesEncodingJSON(t *testing.T) {
+	v := outer{other: &other{}}
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(data, &m); err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	for name := range m {
+		want = append(want, name)
+	}
+	sort.Strings(want)
+
+	var got []string
+	for name := range Of(reflect.TypeOf(v)) {
+		got = append(got, name)
+	}
+	sort.Strings(got)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got fields %v, encoding/json uses %v", got, want)
+	}
+	if f := Of(reflect.TypeOf(v))["b"]; !reflect.DeepEqual(f.Index, []int{0, 1}) {
+		t.Errorf("tagged field b has index %v, want [0 1]", f.Index)
+	}
+}
@@ -0,0 +1,186 @@
+// Package rawjson preserves JSON that Go types do not model so it can be written back out.
+//
+// Capture keeps the original object a value was decoded from, and Merge overlays the
+// re-encoded Go value on it: modeled fields come from the Go value, unmodeled fields
+// come from the original, recursively through nested structs, slices and maps.
+// Fields whose Go types implement json.Marshaler are treated as opaque and are taken
+// from the Go value as-is; they are expected to preserve their own data.
+package rawjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/tmc/figma/internal/jsonfields"
+)
+
+// Object is a captured JSON object.
+type Object map[string]json.RawMessage
+
+var marshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+
+// opaque reports whether values of t take care of their own encoding.
+func opaque(t reflect.Type) bool {
+	if t.Implements(marshalerType) || reflect.PtrTo(t).Implements(marshalerType) {
+		return true
+	}
+	return jsonfields.Deref(t).Kind() == reflect.Interface
+}
+
+// Capture returns the JSON object in data for later use with Merge on a value of type t.
+// Non-empty values of opaque fields are not retained, as they are never used by Merge.
+func Capture(data []byte, t reflect.Type) (Object, error) {
+	var o Object
+	if err := json.Unmarshal(data, &o); err != nil {
+		return nil, err
+	}
+	if o == nil {
+		return nil, nil
+	}
+	for name, f := range jsonfields.Of(t) {
+		if v, ok := o[name]; ok && opaque(f.Type) && !Empty(v) {
+			delete(o, name)
+		}
+	}
+	return o, nil
+}
+
+// Merge overlays typed, the encoding of a value of type t, on the captured object raw.
+// If raw is nil typed is returned unchanged.
+func Merge(raw Object, typed []byte, t reflect.Type) ([]byte, error) {
+	if raw == nil {
+		return typed, nil
+	}
+	var o Object
+	if err := json.Unmarshal(typed, &o); err != nil || o == nil {
+		return typed, err
+	}
+	merged, err := mergeObject(raw, o, t)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(merged)
+}
+
+func mergeObject(raw, typed Object, t reflect.Type) (Object, error) {
+	fields := jsonfields.Of(t)
+	result := make(Object, len(typed))
+	for k, v := range typed {
+		f, known := fields[k]
+		r, inRaw := raw[k]
+		switch {
+		case !inRaw:
+			// fields absent from the original are only written if they carry a value.
+			if !Empty(v) {
+				result[k] = v
+			}
+		case !known || opaque(f.Type):
+			result[k] = v
+		default:
+			m, err := mergeValue(r, v, f.Type)
+			if err != nil {
+				return nil, err
+			}
+			result[k] = m
+		}
+	}
+	for k, r := range raw {
+		if _, ok := typed[k]; ok {
+			continue
+		}
+		// unmodeled fields are kept; modeled fields the Go value omitted are kept only if they were empty to begin with.
+		if _, known := fields[k]; !known || Empty(r) {
+			result[k] = r
+		}
+	}
+	return result, nil
+}
+
+func mergeValue(raw, typed json.RawMessage, t reflect.Type) (json.RawMessage, error) {
+	t = jsonfields.Deref(t)
+	if opaque(t) {
+		return typed, nil
+	}
+	switch t.Kind() {
+	case reflect.Struct:
+		var r, v Object
+		if json.Unmarshal(raw, &r) != nil || json.Unmarshal(typed, &v) != nil || r == nil || v == nil {
+			return typed, nil
+		}
+		m, err := mergeObject(r, v, t)
+		if err != nil {
+			return nil, err
+		}
+		return json.Marshal(m)
+	case reflect.Map:
+		var r, v Object
+		if json.Unmarshal(raw, &r) != nil || json.Unmarshal(typed, &v) != nil || r == nil || v == nil {
+			return typed, nil
+		}
+		for k, tv := range v {
+			if rv, ok := r[k]; ok {
+				m, err := mergeValue(rv, tv, t.Elem())
+				if err != nil {
+					return nil, err
+				}
+				v[k] = m
+			}
+		}
+		return json.Marshal(v)
+	case reflect.Slice, reflect.Array:
+		// Elements are matched by position; those the Go value added or removed
+		// have nothing to merge with.
+		var r, v []json.RawMessage
+		if json.Unmarshal(raw, &r) != nil || json.Unmarshal(typed, &v) != nil {
+			return typed, nil
+		}
+		for i := range v[:min(len(r), len(v))] {
+			m, err := mergeValue(r[i], v[i], t.Elem())
+			if err != nil {
+				return nil, err
+			}
+			v[i] = m
+		}
+		return json.Marshal(v)
+	}
+	return typed, nil
+}
+
+// Empty reports whether v is null, false, zero, an empty string, an empty array, or an
+// object containing only empty values. Arrays with elements are never empty, as
+// encoding/json only omits empty slices; objects may be, as a struct whose fields are
+// all omitted encodes as {}.
+func Empty(v json.RawMessage) bool {
+	v = bytes.TrimSpace(v)
+	if len(v) == 0 {
+		return true
+	}
+	switch v[0] {
+	case 'n', 'f':
+		return true
+	case 't':
+		return false
+	case '"':
+		return len(v) == 2
+	case '[':
+		var a []json.RawMessage
+		if json.Unmarshal(v, &a) != nil {
+			return false
+		}
+		return len(a) == 0
+	case '{':
+		var o Object
+		if json.Unmarshal(v, &o) != nil {
+			return false
+		}
+		for _, e := range o {
+			if !Empty(e) {
+				return false
+			}
+		}
+		return true
+	}
+	var n float64
+	return json.Unmarshal(v, &n) == nil && n == 0
+}
@@ -0,0 +1,219 @@
+package nodes
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/tmc/figma/internal/rawjson"
+)
+
+// Clone returns a deep copy of n, including any fields retained from the JSON it was decoded from
+// and the record of which fields its type styles were decoded with, which TypeStyle.Merge uses.
+// It panics if n cannot be encoded, which only happens for invalid values such as NaN coordinates.
+func Clone[T Node](n T) T {
+	if any(n) == nil {
+		return n
+	}
+	data, err := Marshal(n)
+	if err != nil {
+		panic(fmt.Sprintf("nodes: cannot clone %s %s: %v", n.GetType(), n.GetID(), err))
+	}
+	v := reflect.New(reflect.TypeOf(n).Elem()).Interface().(T)
+	if err := json.Unmarshal(data, v); err != nil {
+		panic(fmt.Sprintf("nodes: cannot clone %s %s: %v", n.GetType(), n.GetID(), err))
+	}
+	if r, ok := any(v).(rawHolder); ok {
+		if raw, err := rawjson.Capture(data, reflect.TypeOf(v)); err == nil {
+			r.setRaw(raw)
+		}
+	}
+	copyTypeStyles(v, n)
+	return v
+}
+
+// copyTypeStyles replaces the type styles under clone, which has the same shape
+// as orig, with copies of those under orig. Decoding the clone records the
+// fields present in its encoding, which are not those of the original and
+// would change the result of TypeStyle.Merge.
+func copyTypeStyles(clone, orig Node) {
+	var origs []Node
+	for _, o := range All(orig) {
+		origs = append(origs, o)
+	}
+	i := 0
+	for _, c := range All(clone) {
+		switch c := c.(type) {
+		case *Text:
+			o := origs[i].(*Text)
+			c.Style = o.Style.Clone()
+			for k := range c.StyleOverrideTable {
+				c.StyleOverrideTable[k] = o.StyleOverrideTable[k].Clone()
+			}
+		case *Connector:
+			if o := origs[i].(*Connector); c.Style != nil && o.Style != nil {
+				*c.Style = o.Style.Clone()
+			}
+		}
+		i++
+	}
+}
+
+// CompareOption configures Equal and Hash.
+type CompareOption func(*compareOptions)
+
+type compareOptions struct {
+	ignoreIDs       bool
+	ignorePositions bool
+}
+
+// IgnoreIDs ignores node IDs and the fields that refer to other nodes by ID:
+// componentId, transitionNodeID, prototypeStartNodeID, exposedInstances, the IDs of
+// instance overrides and the destinationId and nodeId of interactions and flows.
+// Style references are file-local too, so the styles of a node are compared by the
+// kinds of style applied, such as "fill" and "text", and not by style ID.
+// The IDs of variables bound to nodes and paints are still compared.
+func IgnoreIDs() CompareOption {
+	return func(o *compareOptions) { o.ignoreIDs = true }
+}
+
+// IgnorePositions ignores where the subtree is placed: the translation of the root's relative transform
+// is dropped and absolute bounds are made relative to the root's bounding box.
+// The positions of descendants relative to the root are still compared.
+func IgnorePositions() CompareOption {
+	return func(o *compareOptions) { o.ignorePositions = true }
+}
+
+// nodeIDFields are the fields of a node removed by IgnoreIDs.
+var nodeIDFields = []string{"id", "componentId", "transitionNodeID", "prototypeStartNodeID", "exposedInstances"}
+
+// referenceFields are the fields removed by IgnoreIDs at any depth within a node.
+// Unlike "id", which also names variables, they only ever refer to nodes.
+var referenceFields = []string{"destinationId", "nodeId"}
+
+// Equal reports whether the subtrees rooted at a and b have the same content,
+// as encoded by Marshal. Numbers are compared by value, not by their JSON representation.
+func Equal(a, b Node, opts ...CompareOption) bool {
+	ca, err := canonical(a, opts)
+	if err != nil {
+		return false
+	}
+	cb, err := canonical(b, opts)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(ca, cb)
+}
+
+// Hash returns a content hash of the subtree rooted at n. Subtrees that are Equal
+// with the same options have the same hash, regardless of map ordering or the file they come from.
+func Hash(n Node, opts ...CompareOption) ([sha256.Size]byte, error) {
+	c, err := canonical(n, opts)
+	if err != nil {
+		return [sha256.Size]byte{}, err
+	}
+	return sha256.Sum256(c), nil
+}
+
+// canonical returns the JSON encoding of n with the options applied, object keys sorted and numbers normalized.
+func canonical(n Node, opts []CompareOption) ([]byte, error) {
+	var o compareOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+	data, err := Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, err
+	}
+	if root, ok := v.(map[string]interface{}); ok && o.ignoreIDs {
+		stripIDs(root)
+	}
+	if root, ok := v.(map[string]interface{}); ok && o.ignorePositions {
+		if t, ok := root["relativeTransform"].([]interface{}); ok {
+			for _, row := range t {
+				if r, ok := row.([]interface{}); ok && len(r) == 3 {
+					r[2] = 0.0
+				}
+			}
+		}
+		if box, ok := root["absoluteBoundingBox"].(map[string]interface{}); ok {
+			x, _ := box["x"].(float64)
+			y, _ := box["y"].(float64)
+			translateBounds(root, x, y)
+		}
+	}
+	// encoding/json writes map keys in sorted order.
+	return json.Marshal(v)
+}
+
+// stripIDs removes the IDs of node and its descendants and replaces their style IDs with "".
+func stripIDs(node map[string]interface{}) {
+	for _, k := range nodeIDFields {
+		delete(node, k)
+	}
+	if styles, ok := node["styles"].(map[string]interface{}); ok {
+		for k := range styles {
+			styles[k] = ""
+		}
+	}
+	overrides, _ := node["overrides"].([]interface{})
+	for _, o := range overrides {
+		if o, ok := o.(map[string]interface{}); ok {
+			delete(o, "id")
+		}
+	}
+	for k, v := range node {
+		if k != "children" {
+			stripReferences(v)
+		}
+	}
+	children, _ := node["children"].([]interface{})
+	for _, c := range children {
+		if c, ok := c.(map[string]interface{}); ok {
+			stripIDs(c)
+		}
+	}
+}
+
+// stripReferences removes the referenceFields within a value of a node's field.
+func stripReferences(v interface{}) {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for _, k := range referenceFields {
+			delete(v, k)
+		}
+		for _, e := range v {
+			stripReferences(e)
+		}
+	case []interface{}:
+		for _, e := range v {
+			stripReferences(e)
+		}
+	}
+}
+
+// translateBounds moves the absolute bounds of node and its descendants by (-x, -y).
+func translateBounds(node map[string]interface{}, x, y float64) {
+	for _, k := range []string{"absoluteBoundingBox", "absoluteRenderBounds"} {
+		if box, ok := node[k].(map[string]interface{}); ok {
+			if bx, ok := box["x"].(float64); ok {
+				box["x"] = bx - x
+			}
+			if by, ok := box["y"].(float64); ok {
+				box["y"] = by - y
+			}
+		}
+	}
+	children, _ := node["children"].([]interface{})
+	for _, c := range children {
+		if c, ok := c.(map[string]interface{}); ok {
+			translateBounds(c, x, y)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package nodes
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/tmc/figma/figmatypes"
+)
+
+func decodeNode(t *testing.T, s string) Node {
+	t.Helper()
+	var c Children
+	if err := json.Unmarshal([]byte("["+s+"]"), &c); err != nil {
+		t.Fatal(err)
+	}
+	return c[0]
+}
+
+const button = `{
+	"id": "1:1", "type": "COMPONENT", "name": "Button", "pluginExtra": {"b": 1, "a": 2},
+	"absoluteBoundingBox": {"x": 100, "y": 50, "width": 80, "height": 20},
+	"relativeTransform": [[1, 0, 100], [0, 1, 50]],
+	"children": [
+		{"id": "1:2", "type": "TEXT", "name": "Label", "characters": "OK",
+			"styles": {"text": "S:1", "fill": "S:2"},
+			"absoluteBoundingBox": {"x": 108, "y": 54, "width": 64, "height": 12}}
+	]
+}`
+
+// The same button in another file: different node and style IDs, position and key order.
+const movedButton = `{
+	"type": "COMPONENT", "id": "9:1", "name": "Button", "pluginExtra": {"a": 2.0, "b": 1},
+	"absoluteBoundingBox": {"x": 0, "y": 0, "width": 80, "height": 20},
+	"relativeTransform": [[1, 0, 0], [0, 1, 0]],
+	"children": [
+		{"type": "TEXT", "id": "9:2", "name": "Label", "characters": "OK",
+			"styles": {"fill": "S:12", "text": "S:11"},
+			"absoluteBoundingBox": {"x": 8, "y": 4, "width": 64, "height": 12}}
+	]
+}`
+
+func TestEqualAndHash(t *testing.T) {
+	a := decodeNode(t, button)
+	b := decodeNode(t, movedButton)
+
+	if Equal(a, b) {
+		t.Error("Equal() without options ignored IDs and positions")
+	}
+	if Equal(a, b, IgnoreIDs()) {
+		t.Error("Equal(IgnoreIDs) ignored positions")
+	}
+	if !Equal(a, b, IgnoreIDs(), IgnorePositions()) {
+		t.Error("Equal(IgnoreIDs, IgnorePositions) = false")
+	}
+	ha, err := Hash(a, IgnoreIDs(), IgnorePositions())
+	if err != nil {
+		t.Fatal(err)
+	}
+	hb, _ := Hash(b, IgnoreIDs(), IgnorePositions())
+	if ha != hb {
+		t.Error("hashes of equal subtrees differ")
+	}
+
+	c := decodeNode(t, movedButton)
+	c.(*Component).Children[0].(*Text).Characters = "Cancel"
+	if Equal(b, c, IgnoreIDs(), IgnorePositions()) {
+		t.Error("Equal() ignored changed characters")
+	}
+	if hc, _ := Hash(c, IgnoreIDs(), IgnorePositions()); hc == hb {
+		t.Error("hash ignored changed characters")
+	}
+}
+
+func TestClone(t *testing.T) {
+	orig := decodeNode(t, button).(*Component)
+	clone := Clone(orig)
+	if clone == orig || !Equal(orig, clone) {
+		t.Fatal("clone is not an equal copy")
+	}
+	clone.Children[0].(*Text).Characters = "Cancel"
+	clone.Children[0].(*Text).Styles["text"] = "S:3"
+	if got := orig.Children[0].(*Text); got.Characters != "OK" || got.Styles["text"] != "S:1" {
+		t.Errorf("modifying the clone changed the original: %+v", got)
+	}
+
+	a, _ := Marshal(orig)
+	var m map[string]interface{}
+	json.Unmarshal(a, &m)
+	if _, ok := m["pluginExtra"]; !ok {
+		t.Error("original lost unmodeled field")
+	}
+	b, _ := Marshal(clone)
+	m = nil
+	json.Unmarshal(b, &m)
+	if _, ok := m["pluginExtra"]; !ok {
+		t.Error("clone lost unmodeled field")
+	}
+}
+
+func TestCloneCmp(t *testing.T) {
+	orig := decodeNode(t, button).(*Component)
+	if diff := cmp.Diff(orig, Clone(orig), cmpopts.IgnoreUnexported(NodeBase{})); diff != "" {
+		t.Errorf("clone differs (-orig +clone):\n%s", diff)
+	}
+}
+
+func TestEqualBoundVariables(t *testing.T) {
+	const rect = `{"id": %q, "type": "RECTANGLE",
+		"boundVariables": {"opacity": {"type": "VARIABLE_ALIAS", "id": %q}},
+		"fills": [{"type": "SOLID", "color": {"r": 1, "g": 0, "b": 0, "a": 1},
+			"boundVariables": {"color": {"type": "VARIABLE_ALIAS", "id": %q}}}]}`
+	a := decodeNode(t, fmt.Sprintf(rect, "1:1", "V:1", "V:2"))
+	if b := decodeNode(t, fmt.Sprintf(rect, "2:1", "V:1", "V:2")); !Equal(a, b, IgnoreIDs()) {
+		t.Error("nodes bound to the same variables differ")
+	}
+	if b := decodeNode(t, fmt.Sprintf(rect, "2:1", "V:1", "V:3")); Equal(a, b, IgnoreIDs()) {
+		t.Error("paints bound to different variables are equal")
+	}
+	if b := decodeNode(t, fmt.Sprintf(rect, "2:1", "V:3", "V:2")); Equal(a, b, IgnoreIDs()) {
+		t.Error("nodes bound to different variables are equal")
+	}
+}
+
+func TestEqualStyles(t *testing.T) {
+	a := decodeNode(t, `{"id": "1:1", "type": "TEXT", "styles": {"text": "S:1"}}`)
+	if b := decodeNode(t, `{"id": "2:1", "type": "TEXT", "styles": {"fill": "S:1"}}`); Equal(a, b, IgnoreIDs()) {
+		t.Error("nodes with different kinds of style are equal")
+	}
+}
+
+func TestCloneTypeStyles(t *testing.T) {
+	built := &Text{
+		Style:              figmatypes.TypeStyle{FontSize: 12, LetterSpacing: 2},
+		StyleOverrideTable: map[int]figmatypes.TypeStyle{1: {FontWeight: 700}},
+	}
+	decoded := decodeNode(t, `{"id": "1:1", "type": "TEXT",
+		"style": {"fontSize": 12, "letterSpacing": 2},
+		"styleOverrideTable": {"1": {"fontWeight": 700}}}`).(*Text)
+	for name, orig := range map[string]*Text{"built": built, "decoded": decoded} {
+		want := orig.Style.Merge(orig.StyleOverrideTable[1])
+		clone := Clone(orig)
+		if got := clone.Style.Merge(clone.StyleOverrideTable[1]); got.LetterSpacing != want.LetterSpacing || got.FontWeight != want.FontWeight {
+			t.Errorf("%s: merged clone style has letter spacing %v and weight %v, want %v and %v",
+				name, got.LetterSpacing, got.FontWeight, want.LetterSpacing, want.FontWeight)
+		}
+	}
+}
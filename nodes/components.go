@@ -0,0 +1,85 @@
+package nodes
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// ParseVariantName parses the name of a variant component, such as "Size=Large, State=Hover", into a map of property names to values.
+// It returns nil if name is not a variant name.
+func ParseVariantName(name string) map[string]string {
+	result := make(map[string]string)
+	for _, part := range strings.Split(name, ",") {
+		k, v, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil
+		}
+		k = strings.TrimSpace(k)
+		if k == "" {
+			return nil
+		}
+		result[k] = strings.TrimSpace(v)
+	}
+	return result
+}
+
+// FormatVariantName formats variant properties as a variant name, ordering properties by name.
+func FormatVariantName(props map[string]string) string {
+	keys := make([]string, 0, len(props))
+	for k := range props {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + props[k]
+	}
+	return strings.Join(parts, ", ")
+}
+
+// SplitPropertyName splits a component property name such as "Label#2:0" into its display name and ID.
+// VARIANT property names have no ID.
+func SplitPropertyName(name string) (displayName, id string) {
+	if i := strings.LastIndex(name, "#"); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return name, ""
+}
+
+// VariantProperties returns the variant properties of the component, parsed from its name.
+// It returns nil if the component is not a variant.
+func (c *Component) VariantProperties() map[string]string {
+	return ParseVariantName(c.Name)
+}
+
+// VariantProperties returns the values of the instance's VARIANT properties, keyed by property name.
+func (i *Instance) VariantProperties() map[string]string {
+	var result map[string]string
+	for k, p := range i.ComponentProperties {
+		if p.Type != figmatypes.ComponentPropertyTypeVARIANT {
+			continue
+		}
+		if result == nil {
+			result = make(map[string]string)
+		}
+		result[k] = p.Value.String
+	}
+	return result
+}
+
+// VariantOptions returns the possible values of each VARIANT property of the component set, keyed by property name.
+func (s *ComponentSet) VariantOptions() map[string][]string {
+	var result map[string][]string
+	for k, d := range s.ComponentPropertyDefinitions {
+		if d.Type != figmatypes.ComponentPropertyTypeVARIANT {
+			continue
+		}
+		if result == nil {
+			result = make(map[string][]string)
+		}
+		result[k] = d.VariantOptions
+	}
+	return result
+}
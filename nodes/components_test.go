@@ -0,0 +1,64 @@
+package nodes
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+func TestParseVariantName(t *testing.T) {
+	cases := []struct {
+		in   string
+		want map[string]string
+	}{
+		{"Size=Large, State=Hover", map[string]string{"Size": "Large", "State": "Hover"}},
+		{"Type=Primary", map[string]string{"Type": "Primary"}},
+		{"Button", nil},
+		{"Size=Large, Hover", nil},
+	}
+	for _, tt := range cases {
+		got := ParseVariantName(tt.in)
+		if !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("ParseVariantName(%q) = %v, want %v", tt.in, got, tt.want)
+		}
+		if tt.want != nil {
+			if again := ParseVariantName(FormatVariantName(got)); !reflect.DeepEqual(again, tt.want) {
+				t.Errorf("FormatVariantName(%v) did not round trip: %v", got, again)
+			}
+		}
+	}
+}
+
+func TestInstanceProperties(t *testing.T) {
+	var c Children
+	err := json.Unmarshal([]byte(`[{
+		"id": "1:4",
+		"type": "INSTANCE",
+		"componentId": "1:2",
+		"componentProperties": {
+			"Size": {"type": "VARIANT", "value": "Large"},
+			"Show icon#1:0": {"type": "BOOLEAN", "value": false},
+			"Label#2:0": {"type": "TEXT", "value": "Buy"}
+		},
+		"overrides": [{"id": "I1:4;1:3", "overriddenFields": ["characters"]}]
+	}]`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	i := c[0].(*Instance)
+	if got := i.VariantProperties(); !reflect.DeepEqual(got, map[string]string{"Size": "Large"}) {
+		t.Errorf("got variant properties %v", got)
+	}
+	show := i.ComponentProperties["Show icon#1:0"]
+	if show.Type != figmatypes.ComponentPropertyTypeBOOLEAN || !show.Value.IsBool || show.Value.Bool {
+		t.Errorf("got boolean property %+v", show)
+	}
+	if name, id := SplitPropertyName("Label#2:0"); name != "Label" || id != "2:0" {
+		t.Errorf("SplitPropertyName = %q, %q", name, id)
+	}
+	if len(i.Overrides) != 1 || i.Overrides[0].OverriddenFields[0] != "characters" {
+		t.Errorf("got overrides %+v", i.Overrides)
+	}
+}
@@ -1,2 +1,4 @@
 // Package nodes describes the possible figma node types.
 package nodes
+
+//go:generate go run ../internal/cmd/enumgen
@@ -0,0 +1,135 @@
+// Code generated by enumgen. DO NOT EDIT.
+
+package nodes
+
+// String returns the value as a string.
+func (v NodeType) String() string { return string(v) }
+
+// IsValid reports whether v is a known NodeType.
+func (v NodeType) IsValid() bool {
+	switch v {
+	case NodeTypeDOCUMENT,
+		NodeTypeCANVAS,
+		NodeTypeFRAME,
+		NodeTypeGROUP,
+		NodeTypeVECTOR,
+		NodeTypeBOOLEAN,
+		NodeTypeSTAR,
+		NodeTypeLINE,
+		NodeTypeELLIPSE,
+		NodeTypeREGULAR_POLYGON,
+		NodeTypeRECTANGLE,
+		NodeTypeTEXT,
+		NodeTypeSLICE,
+		NodeTypeCOMPONENT,
+		NodeTypeINSTANCE,
+		NodeTypeBOOLEAN_OPERATION,
+		NodeTypeSECTION,
+		NodeTypeCOMPONENT_SET,
+		NodeTypeTRANSFORM_GROUP,
+		NodeTypeTEXT_PATH,
+		NodeTypeSTICKY,
+		NodeTypeSHAPE_WITH_TEXT,
+		NodeTypeCONNECTOR,
+		NodeTypeTABLE,
+		NodeTypeTABLE_CELL,
+		NodeTypeWIDGET,
+		NodeTypeEMBED,
+		NodeTypeLINK_UNFURL,
+		NodeTypeWASHI_TAPE,
+		NodeTypeHIGHLIGHT,
+		NodeTypeSTAMP,
+		NodeTypeMEDIA,
+		NodeTypeSLIDE,
+		NodeTypeSLIDE_ROW,
+		NodeTypeSLIDE_GRID,
+		NodeTypeINTERACTIVE_SLIDE_ELEMENT:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v NodeType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *NodeType) UnmarshalText(text []byte) error {
+	*v = NodeType(text)
+	return nil
+}
+
+// NodeTypeValues returns the known NodeType values.
+func NodeTypeValues() []NodeType {
+	return []NodeType{
+		NodeTypeDOCUMENT,
+		NodeTypeCANVAS,
+		NodeTypeFRAME,
+		NodeTypeGROUP,
+		NodeTypeVECTOR,
+		NodeTypeBOOLEAN,
+		NodeTypeSTAR,
+		NodeTypeLINE,
+		NodeTypeELLIPSE,
+		NodeTypeREGULAR_POLYGON,
+		NodeTypeRECTANGLE,
+		NodeTypeTEXT,
+		NodeTypeSLICE,
+		NodeTypeCOMPONENT,
+		NodeTypeINSTANCE,
+		NodeTypeBOOLEAN_OPERATION,
+		NodeTypeSECTION,
+		NodeTypeCOMPONENT_SET,
+		NodeTypeTRANSFORM_GROUP,
+		NodeTypeTEXT_PATH,
+		NodeTypeSTICKY,
+		NodeTypeSHAPE_WITH_TEXT,
+		NodeTypeCONNECTOR,
+		NodeTypeTABLE,
+		NodeTypeTABLE_CELL,
+		NodeTypeWIDGET,
+		NodeTypeEMBED,
+		NodeTypeLINK_UNFURL,
+		NodeTypeWASHI_TAPE,
+		NodeTypeHIGHLIGHT,
+		NodeTypeSTAMP,
+		NodeTypeMEDIA,
+		NodeTypeSLIDE,
+		NodeTypeSLIDE_ROW,
+		NodeTypeSLIDE_GRID,
+		NodeTypeINTERACTIVE_SLIDE_ELEMENT,
+	}
+}
+
+// String returns the value as a string.
+func (v StrokeAlignType) String() string { return string(v) }
+
+// IsValid reports whether v is a known StrokeAlignType.
+func (v StrokeAlignType) IsValid() bool {
+	switch v {
+	case StrokeAlignTypeINSIDE,
+		StrokeAlignTypeOUTSIDE,
+		StrokeAlignTypeCENTER:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v StrokeAlignType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *StrokeAlignType) UnmarshalText(text []byte) error {
+	*v = StrokeAlignType(text)
+	return nil
+}
+
+// StrokeAlignTypeValues returns the known StrokeAlignType values.
+func StrokeAlignTypeValues() []StrokeAlignType {
+	return []StrokeAlignType{
+		StrokeAlignTypeINSIDE,
+		StrokeAlignTypeOUTSIDE,
+		StrokeAlignTypeCENTER,
+	}
+}
@@ -0,0 +1,103 @@
+package nodes
+
+import "github.com/tmc/figma/figmatypes"
+
+// FigJam Types
+
+// Sticky is a FigJam sticky note.
+type Sticky struct {
+	Vector
+	// Text contained within the sticky.
+	Characters string `json:"characters,omitempty"`
+	// Whether the author's name is shown on the sticky.
+	AuthorVisible bool `json:"authorVisible,omitempty"`
+}
+
+// ShapeWithText is a FigJam shape with a text label.
+type ShapeWithText struct {
+	Vector
+	// The shape drawn.
+	ShapeType figmatypes.ShapeType `json:"shapeType,omitempty"`
+	// Text contained within the shape.
+	Characters string `json:"characters,omitempty"`
+	// Radius of each corner of the shape, for shapes with corners.
+	CornerRadius float64 `json:"cornerRadius,omitempty"`
+}
+
+// Connector is a FigJam line connecting nodes or positions.
+type Connector struct {
+	Vector
+	// Where the connector starts.
+	ConnectorStart figmatypes.ConnectorEndpoint `json:"connectorStart"`
+	// Where the connector ends.
+	ConnectorEnd figmatypes.ConnectorEndpoint `json:"connectorEnd"`
+	// Decoration at the start of the connector.
+	ConnectorStartStrokeCap figmatypes.ConnectorStrokeCap `json:"connectorStartStrokeCap,omitempty"`
+	// Decoration at the end of the connector.
+	ConnectorEndStrokeCap figmatypes.ConnectorStrokeCap `json:"connectorEndStrokeCap,omitempty"`
+	// How the connector is routed.
+	ConnectorLineType figmatypes.ConnectorLineType `json:"connectorLineType,omitempty"`
+	// Label of the connector.
+	Characters string `json:"characters,omitempty"`
+	// Style of the label.
+	Style *figmatypes.TypeStyle `json:"style,omitempty"`
+	// Background behind the label.
+	TextBackground *figmatypes.ConnectorTextBackground `json:"textBackground,omitempty"`
+}
+
+// Table is a FigJam table. Its children are TableCells.
+type Table struct {
+	Vector
+	Children Children `json:"children,omitempty"`
+}
+
+func (t *Table) GetChildren() Children {
+	return t.Children
+}
+
+// TableCell is a cell of a Table.
+type TableCell struct {
+	Vector
+	// Text contained within the cell.
+	Characters string `json:"characters,omitempty"`
+}
+
+// Widget is an instance of a FigJam or Figma widget.
+type Widget struct {
+	Vector
+	Children Children `json:"children,omitempty"`
+}
+
+func (w *Widget) GetChildren() Children {
+	return w.Children
+}
+
+// Embed is embedded content from another site.
+type Embed struct {
+	Vector
+}
+
+// LinkUnfurl is a preview of a pasted link.
+type LinkUnfurl struct {
+	Vector
+}
+
+// WashiTape is a FigJam washi tape strip.
+type WashiTape struct {
+	Vector
+}
+
+// Highlight is a FigJam highlighter stroke.
+type Highlight struct {
+	Vector
+}
+
+// Stamp is a FigJam stamp.
+type Stamp struct {
+	Vector
+}
+
+// Media is a video or animated image.
+type Media struct {
+	Vector
+}
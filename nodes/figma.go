@@ -2,8 +2,10 @@ package nodes
 
 import (
 	"encoding/json"
+	"reflect"
 
 	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/internal/rawjson"
 )
 
 // NodeType describes the type of a node.
@@ -11,24 +13,49 @@ type NodeType string
 
 const (
 	NodeTypeDOCUMENT        NodeType = "DOCUMENT"
-	NodeTypeCANVAS                   = "CANVAS"
-	NodeTypeFRAME                    = "FRAME"
-	NodeTypeGROUP                    = "GROUP"
-	NodeTypeVECTOR                   = "VECTOR"
-	NodeTypeBOOLEAN                  = "BOOLEAN"
-	NodeTypeSTAR                     = "STAR"
-	NodeTypeLINE                     = "LINE"
-	NodeTypeELLIPSE                  = "ELLIPSE"
-	NodeTypeREGULAR_POLYGON          = "REGULAR_POLYGON"
-	NodeTypeRECTANGLE                = "RECTANGLE"
-	NodeTypeTEXT                     = "TEXT"
-	NodeTypeSLICE                    = "SLICE"
-	NodeTypeCOMPONENT                = "COMPONENT"
-	NodeTypeINSTANCE                 = "INSTANCE"
+	NodeTypeCANVAS          NodeType = "CANVAS"
+	NodeTypeFRAME           NodeType = "FRAME"
+	NodeTypeGROUP           NodeType = "GROUP"
+	NodeTypeVECTOR          NodeType = "VECTOR"
+	NodeTypeBOOLEAN         NodeType = "BOOLEAN"
+	NodeTypeSTAR            NodeType = "STAR"
+	NodeTypeLINE            NodeType = "LINE"
+	NodeTypeELLIPSE         NodeType = "ELLIPSE"
+	NodeTypeREGULAR_POLYGON NodeType = "REGULAR_POLYGON"
+	NodeTypeRECTANGLE       NodeType = "RECTANGLE"
+	NodeTypeTEXT            NodeType = "TEXT"
+	NodeTypeSLICE           NodeType = "SLICE"
+	NodeTypeCOMPONENT       NodeType = "COMPONENT"
+	NodeTypeINSTANCE        NodeType = "INSTANCE"
+
+	NodeTypeBOOLEAN_OPERATION         NodeType = "BOOLEAN_OPERATION"
+	NodeTypeSECTION                   NodeType = "SECTION"
+	NodeTypeCOMPONENT_SET             NodeType = "COMPONENT_SET"
+	NodeTypeTRANSFORM_GROUP           NodeType = "TRANSFORM_GROUP"
+	NodeTypeTEXT_PATH                 NodeType = "TEXT_PATH"
+	NodeTypeSTICKY                    NodeType = "STICKY"
+	NodeTypeSHAPE_WITH_TEXT           NodeType = "SHAPE_WITH_TEXT"
+	NodeTypeCONNECTOR                 NodeType = "CONNECTOR"
+	NodeTypeTABLE                     NodeType = "TABLE"
+	NodeTypeTABLE_CELL                NodeType = "TABLE_CELL"
+	NodeTypeWIDGET                    NodeType = "WIDGET"
+	NodeTypeEMBED                     NodeType = "EMBED"
+	NodeTypeLINK_UNFURL               NodeType = "LINK_UNFURL"
+	NodeTypeWASHI_TAPE                NodeType = "WASHI_TAPE"
+	NodeTypeHIGHLIGHT                 NodeType = "HIGHLIGHT"
+	NodeTypeSTAMP                     NodeType = "STAMP"
+	NodeTypeMEDIA                     NodeType = "MEDIA"
+	NodeTypeSLIDE                     NodeType = "SLIDE"
+	NodeTypeSLIDE_ROW                 NodeType = "SLIDE_ROW"
+	NodeTypeSLIDE_GRID                NodeType = "SLIDE_GRID"
+	NodeTypeINTERACTIVE_SLIDE_ELEMENT NodeType = "INTERACTIVE_SLIDE_ELEMENT"
 )
 
+// Children is a list of nodes of any type.
+// Its JSON encoding preserves the fields and node types that the Go types do not model.
 type Children []Node
 
+// UnmarshalJSON decodes each node into the Go type for its node type.
 func (c *Children) UnmarshalJSON(data []byte) error {
 	var v []json.RawMessage
 	type justType struct {
@@ -37,7 +64,7 @@ func (c *Children) UnmarshalJSON(data []byte) error {
 	if err := json.Unmarshal(data, &v); err != nil {
 		return err
 	}
-	var result Children
+	result := make(Children, 0, len(v))
 	for _, n := range v {
 		var jt justType
 		err := json.Unmarshal([]byte(n), &jt)
@@ -45,48 +72,139 @@ func (c *Children) UnmarshalJSON(data []byte) error {
 			return err
 		}
 
-		var v Node
-		switch jt.Type {
-		case NodeTypeDOCUMENT:
-			v = &Document{}
-		case NodeTypeCANVAS:
-			v = &Canvas{}
-		case NodeTypeFRAME:
-			v = &Frame{}
-		case NodeTypeGROUP:
-			v = &Group{}
-		case NodeTypeVECTOR:
-			v = &Vector{}
-		case NodeTypeBOOLEAN:
-			v = &Boolean{}
-		case NodeTypeSTAR:
-			v = &Star{}
-		case NodeTypeLINE:
-			v = &Line{}
-		case NodeTypeELLIPSE:
-			v = &Ellipse{}
-		case NodeTypeREGULAR_POLYGON:
-			v = &RegularPolygon{}
-		case NodeTypeRECTANGLE:
-			v = &Rectangle{}
-		case NodeTypeTEXT:
-			v = &Text{}
-		case NodeTypeSLICE:
-			v = &Slice{}
-		case NodeTypeCOMPONENT:
-			v = &Component{}
-		case NodeTypeINSTANCE:
-			v = &Instance{}
-		default:
-			v = &Unknown{}
+		v := New(jt.Type)
+		if err := json.Unmarshal([]byte(n), &v); err != nil {
+			return err
+		}
+		raw, err := rawjson.Capture(n, reflect.TypeOf(v))
+		if err != nil {
+			return err
 		}
-		err = json.Unmarshal([]byte(n), &v)
+		v.(rawHolder).setRaw(raw)
 		result = append(result, v)
 	}
 	*c = result
 	return nil
 }
 
+// MarshalJSON encodes each node with Marshal.
+func (c Children) MarshalJSON() ([]byte, error) {
+	if c == nil {
+		return []byte("null"), nil
+	}
+	result := make([]json.RawMessage, len(c))
+	for i, n := range c {
+		b, err := Marshal(n)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = b
+	}
+	return json.Marshal(result)
+}
+
+// Marshal returns the JSON encoding of a node.
+// Nodes that were decoded from JSON retain the fields their Go type does not model,
+// including the entire contents of Unknown nodes, and those are written back out.
+func Marshal(n Node) ([]byte, error) {
+	b, err := json.Marshal(n)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := n.(json.Marshaler); ok {
+		return b, nil
+	}
+	r, ok := n.(rawHolder)
+	if !ok {
+		return b, nil
+	}
+	return rawjson.Merge(r.getRaw(), b, reflect.TypeOf(n))
+}
+
+// rawHolder is implemented by nodes embedding NodeBase.
+type rawHolder interface {
+	getRaw() rawjson.Object
+	setRaw(rawjson.Object)
+}
+
+// New returns a pointer to a new zero node of the Go type used to decode nodes of type t.
+// Unrecognized types are returned as *Unknown.
+func New(t NodeType) Node {
+	switch t {
+	case NodeTypeDOCUMENT:
+		return &Document{}
+	case NodeTypeCANVAS:
+		return &Canvas{}
+	case NodeTypeFRAME:
+		return &Frame{}
+	case NodeTypeGROUP:
+		return &Group{}
+	case NodeTypeVECTOR:
+		return &Vector{}
+	case NodeTypeBOOLEAN, NodeTypeBOOLEAN_OPERATION:
+		return &Boolean{}
+	case NodeTypeSTAR:
+		return &Star{}
+	case NodeTypeLINE:
+		return &Line{}
+	case NodeTypeELLIPSE:
+		return &Ellipse{}
+	case NodeTypeREGULAR_POLYGON:
+		return &RegularPolygon{}
+	case NodeTypeRECTANGLE:
+		return &Rectangle{}
+	case NodeTypeTEXT:
+		return &Text{}
+	case NodeTypeSLICE:
+		return &Slice{}
+	case NodeTypeCOMPONENT:
+		return &Component{}
+	case NodeTypeINSTANCE:
+		return &Instance{}
+	case NodeTypeSECTION:
+		return &Section{}
+	case NodeTypeCOMPONENT_SET:
+		return &ComponentSet{}
+	case NodeTypeTRANSFORM_GROUP:
+		return &TransformGroup{}
+	case NodeTypeTEXT_PATH:
+		return &TextPath{}
+	case NodeTypeSTICKY:
+		return &Sticky{}
+	case NodeTypeSHAPE_WITH_TEXT:
+		return &ShapeWithText{}
+	case NodeTypeCONNECTOR:
+		return &Connector{}
+	case NodeTypeTABLE:
+		return &Table{}
+	case NodeTypeTABLE_CELL:
+		return &TableCell{}
+	case NodeTypeWIDGET:
+		return &Widget{}
+	case NodeTypeEMBED:
+		return &Embed{}
+	case NodeTypeLINK_UNFURL:
+		return &LinkUnfurl{}
+	case NodeTypeWASHI_TAPE:
+		return &WashiTape{}
+	case NodeTypeHIGHLIGHT:
+		return &Highlight{}
+	case NodeTypeSTAMP:
+		return &Stamp{}
+	case NodeTypeMEDIA:
+		return &Media{}
+	case NodeTypeSLIDE:
+		return &Slide{}
+	case NodeTypeSLIDE_ROW:
+		return &SlideRow{}
+	case NodeTypeSLIDE_GRID:
+		return &SlideGrid{}
+	case NodeTypeINTERACTIVE_SLIDE_ELEMENT:
+		return &InteractiveSlideElement{}
+	}
+	return &Unknown{}
+}
+
 type Node interface {
 	GetID() string
 	GetName() string
@@ -98,8 +216,44 @@ type Parent interface {
 	GetChildren() Children
 }
 
+// Clipper is implemented by nodes that can clip their children.
+type Clipper interface {
+	GetClipsContent() bool
+}
+
+// VariableBound is implemented by nodes that can have variables bound to their fields.
+type VariableBound interface {
+	GetBoundVariables() map[string]figmatypes.VariableBinding
+	GetExplicitVariableModes() map[string]string
+}
+
+// Styled is implemented by nodes that can reference styles.
+type Styled interface {
+	GetStyles() map[string]string
+}
+
+// Painted is implemented by nodes that can have fills, strokes and effects.
+type Painted interface {
+	GetFills() []figmatypes.Paint
+	GetStrokes() []figmatypes.Paint
+	GetEffects() []figmatypes.Effect
+}
+
+// Gridded is implemented by nodes that can have layout grids.
+type Gridded interface {
+	GetLayoutGrids() []figmatypes.LayoutGrid
+}
+
+// Interactive is implemented by nodes that can carry prototype interactions.
+type Interactive interface {
+	GetInteractions() []figmatypes.Interaction
+	GetTransitionNodeID() string
+}
+
+// Unknown is a node of a type that is not modeled. Its fields are preserved when encoded with Marshal.
+// Any children it has are decoded so that traversals reach them.
 type Unknown struct {
-	NodeBase
+	ParentNodeBase
 }
 
 // NodeBase contains common fields for every  type.
@@ -108,6 +262,32 @@ type NodeBase struct {
 	Name    string   `json:"name,omitempty"`
 	Type    NodeType `json:"type,omitempty"`
 	Visible *bool    `json:"visible,omitempty"`
+	// Maps node fields, such as "characters", "visible" or "mainComponent", to the names of the component properties that control them.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	// The variables bound to fields of the node, keyed by field name, such as "fills", "paddingLeft" or "characters".
+	BoundVariables map[string]figmatypes.VariableBinding `json:"boundVariables,omitempty"`
+	// The modes set on the node for variable collections, keyed by collection ID. Descendants inherit them.
+	ExplicitVariableModes map[string]string `json:"explicitVariableModes,omitempty"`
+	// Data stored on the node by plugins, keyed by plugin ID and then by key.
+	// Only present for the plugins listed in the request's plugin_data parameter.
+	PluginData map[string]map[string]string `json:"pluginData,omitempty"`
+	// Data stored on the node with setSharedPluginData, keyed by namespace and then by key.
+	// Only present if the request's plugin_data parameter includes "shared".
+	SharedPluginData map[string]map[string]string `json:"sharedPluginData,omitempty"`
+
+	// the JSON object the node was decoded from, if any, for the fields no Go
+	// field models. Marshal and Clone keep it; Equal and Hash compare it.
+	// go-cmp cannot see it, so compare nodes with go-cmp using
+	// cmpopts.IgnoreUnexported(nodes.NodeBase{}), or use Equal.
+	raw rawjson.Object
+}
+
+func (b *NodeBase) getRaw() rawjson.Object {
+	return b.raw
+}
+
+func (b *NodeBase) setRaw(raw rawjson.Object) {
+	b.raw = raw
 }
 
 // ParentNodeBase adds Children to NodeBase.
@@ -133,6 +313,16 @@ func (b *NodeBase) GetVisible() *bool {
 	return b.Visible
 }
 
+// GetBoundVariables returns the variables bound to fields of the node.
+func (b *NodeBase) GetBoundVariables() map[string]figmatypes.VariableBinding {
+	return b.BoundVariables
+}
+
+// GetExplicitVariableModes returns the variable modes set on the node, keyed by collection ID.
+func (b *NodeBase) GetExplicitVariableModes() map[string]string {
+	return b.ExplicitVariableModes
+}
+
 func (b *ParentNodeBase) GetChildren() Children {
 	return b.Children
 }
@@ -144,13 +334,38 @@ type Document struct {
 	ParentNodeBase
 }
 
+// UnmarshalJSON decodes a document, retaining the fields its Go type does not model.
+func (d *Document) UnmarshalJSON(data []byte) error {
+	type document Document
+	if err := json.Unmarshal(data, (*document)(d)); err != nil {
+		return err
+	}
+	raw, err := rawjson.Capture(data, reflect.TypeOf(d))
+	d.raw = raw
+	return err
+}
+
+// MarshalJSON encodes a document along with any fields retained when it was decoded.
+func (d Document) MarshalJSON() ([]byte, error) {
+	type document Document
+	b, err := json.Marshal(document(d))
+	if err != nil {
+		return nil, err
+	}
+	return rawjson.Merge(d.raw, b, reflect.TypeOf(d))
+}
+
 // Canvas is represents a single page.
 type Canvas struct {
 	ParentNodeBase
 	// Background color of the canvas.
 	BackgroundColor figmatypes.Color `json:"backgroundColor,omitempty"`
 	// An array of export settings representing images to export from the canvas.
-	ExportSettings []figmatypes.ExportSetting `json:"exportSettings"`
+	ExportSettings []figmatypes.ExportSetting `json:"exportSettings,omitempty"`
+	// The starting points of the prototype flows on this canvas.
+	FlowStartingPoints []figmatypes.FlowStartingPoint `json:"flowStartingPoints,omitempty"`
+	// ID of the node the prototype starts at, if set.
+	PrototypeStartNodeID string `json:"prototypeStartNodeID,omitempty"`
 }
 
 // Frame is a node of fixed size containing other nodes.
@@ -159,7 +374,7 @@ type Frame struct {
 	// Background color of the node.
 	BackgroundColor figmatypes.Color `json:"backgroundColor"`
 	// An array of export settings representing images to export from node.
-	ExportSettings []figmatypes.ExportSetting `json:"exportSettings,omitEmpty"`
+	ExportSettings []figmatypes.ExportSetting `json:"exportSettings,omitempty"`
 	// How this node blends with nodes behind it in the scene (see blend mode section for more details).
 	BlendMode figmatypes.BlendMode `json:"blendMode,omitempty"`
 	// Keep height and width constrained to same ratio. default: false.
@@ -168,14 +383,18 @@ type Frame struct {
 	Constraints figmatypes.LayoutConstraint `json:"constraints,omitempty"`
 	//  ID of node to transition to in prototyping. default: null.
 	TransitionNodeID string `json:"transitionNodeID,omitempty"`
+	// The prototype interactions on this node.
+	Interactions []figmatypes.Interaction `json:"interactions,omitempty"`
+	// The prototype interactions on this node, in their legacy form.
+	Reactions []figmatypes.Interaction `json:"reactions,omitempty"`
 	// Opacity of the node. default: 1.
 	Opacity float64 `json:"opacity,omitempty"`
 	// Bounding box of the node in absolute space coordinates.
 	AbsoluteBoundingBox figmatypes.Rectangle `json:"absoluteBoundingBox,omitempty"`
 	// Width and height of element. This is different from the width and height of the bounding box in that the absolute bounding box represents the element after scaling and rotation. Only present if geometry=paths is passed.
-	Size *Vector `json:"size,omitempty"`
+	Size *figmatypes.Vector `json:"size,omitempty"`
 	// The top two rows of a matrix that represents the 2D transform of this node relative to its parent. The bottom row of the matrix is implicitly always (0, 0, 1). Use to transform coordinates in geometry. Only present if geometry=paths is passed.
-	RelativeTransform figmatypes.Transform `json:"relativeTransform,omitempty"`
+	RelativeTransform *figmatypes.Transform `json:"relativeTransform,omitempty"`
 	// Does this node clip content outside of its bounds?.
 	ClipsContent bool `json:"clipsContent"`
 	// An array of layout grids attached to this node (see layout grids section for more details). GROUP nodes do not have this attribute.
@@ -184,24 +403,171 @@ type Frame struct {
 	Effects []figmatypes.Effect `json:"effects"`
 	// Does this node mask sibling nodes in front of it?. default: false.
 	IsMask bool `json:"isMask,omitempty"`
+	// A mapping of a lower-case style type, such as "fill", "text" or "effect", to the ID (see Style) of styles present on this node. The style ID can be used to look up more information about the style in the top-level styles field.
+	Styles map[string]string `json:"styles,omitempty"`
+	// Bounds of the rendered node in absolute space coordinates, including effects and strokes. Null for nodes that are not rendered.
+	AbsoluteRenderBounds *figmatypes.Rectangle `json:"absoluteRenderBounds,omitempty"`
+	// An array of fill paints applied to the node.
+	Fills []figmatypes.Paint `json:"fills,omitempty"`
+	// Only specified if parameter geometry=paths is used. An array of paths representing the object fill.
+	FillGeometry []figmatypes.Path `json:"fillGeometry,omitempty"`
+	// An array of stroke paints applied to the node.
+	Strokes []figmatypes.Paint `json:"strokes,omitempty"`
+	// The weight of strokes on the node.
+	StrokeWeight float64 `json:"strokeWeight,omitempty"`
+	// The weight of strokes on each side of the node, if they differ.
+	IndividualStrokeWeights *figmatypes.StrokeWeights `json:"individualStrokeWeights,omitempty"`
+	// Only specified if parameter geometry=paths is used. An array of paths representing the object stroke.
+	StrokeGeometry []figmatypes.Path `json:"strokeGeometry,omitempty"`
+	// Where stroke is drawn relative to the node outline.
+	StrokeAlign StrokeAlignType `json:"strokeAlign,omitempty"`
+	// The decoration where stroke segments meet. default: MITER.
+	StrokeJoin figmatypes.StrokeJoin `json:"strokeJoin,omitempty"`
+	// Alternating lengths of dashes and gaps in a dashed stroke. Empty for solid strokes.
+	StrokeDashes []float64 `json:"strokeDashes,omitempty"`
+	// The angle in degrees below which a mitered join is beveled. default: 28.96.
+	StrokeMiterAngle float64 `json:"strokeMiterAngle,omitempty"`
+	// Radius of each corner of the node if a single radius is set for all corners.
+	CornerRadius float64 `json:"cornerRadius,omitempty"`
+	// Radii of the top left, top right, bottom right and bottom left corners, if they differ.
+	RectangleCornerRadii []float64 `json:"rectangleCornerRadii,omitempty"`
+	// How much corners are smoothed, from 0 for circular corners to 1 for iOS-style squircles.
+	CornerSmoothing float64 `json:"cornerSmoothing,omitempty"`
+	// Whether this node uses auto layout, and in which direction. default: NONE.
+	LayoutMode figmatypes.LayoutMode `json:"layoutMode,omitempty"`
+	// Whether the frame is sized by its children along the layout direction. default: AUTO.
+	PrimaryAxisSizingMode figmatypes.AxisSizingMode `json:"primaryAxisSizingMode,omitempty"`
+	// Whether the frame is sized by its children perpendicular to the layout direction. default: AUTO.
+	CounterAxisSizingMode figmatypes.AxisSizingMode `json:"counterAxisSizingMode,omitempty"`
+	// How children are aligned along the layout direction. default: MIN.
+	PrimaryAxisAlignItems figmatypes.PrimaryAxisAlignItems `json:"primaryAxisAlignItems,omitempty"`
+	// How children are aligned perpendicular to the layout direction. default: MIN.
+	CounterAxisAlignItems figmatypes.CounterAxisAlignItems `json:"counterAxisAlignItems,omitempty"`
+	// How wrapped rows or columns are distributed. default: AUTO.
+	CounterAxisAlignContent figmatypes.CounterAxisAlignContent `json:"counterAxisAlignContent,omitempty"`
+	// The distance between children along the layout direction. default: 0.
+	ItemSpacing float64 `json:"itemSpacing,omitempty"`
+	// The distance between wrapped rows or columns. default: 0.
+	CounterAxisSpacing float64 `json:"counterAxisSpacing,omitempty"`
+	// The padding between the left border of the frame and its children. default: 0.
+	PaddingLeft float64 `json:"paddingLeft,omitempty"`
+	// The padding between the right border of the frame and its children. default: 0.
+	PaddingRight float64 `json:"paddingRight,omitempty"`
+	// The padding between the top border of the frame and its children. default: 0.
+	PaddingTop float64 `json:"paddingTop,omitempty"`
+	// The padding between the bottom border of the frame and its children. default: 0.
+	PaddingBottom float64 `json:"paddingBottom,omitempty"`
+	// Whether children wrap onto new rows or columns. default: NO_WRAP.
+	LayoutWrap figmatypes.LayoutWrap `json:"layoutWrap,omitempty"`
+	// Whether the first child is drawn on top. default: false.
+	ItemReverseZIndex bool `json:"itemReverseZIndex,omitempty"`
+	// Whether strokes are included in layout calculations. default: false.
+	StrokesIncludedInLayout bool `json:"strokesIncludedInLayout,omitempty"`
+	// How the node is aligned perpendicular to the layout direction of its auto layout parent.
+	LayoutAlign figmatypes.LayoutAlign `json:"layoutAlign,omitempty"`
+	// Whether the node stretches along the layout direction of its auto layout parent: 0 for fixed, 1 for stretch.
+	LayoutGrow float64 `json:"layoutGrow,omitempty"`
+	// Whether the node is positioned absolutely within its auto layout parent.
+	LayoutPositioning figmatypes.LayoutPositioning `json:"layoutPositioning,omitempty"`
+	// How the node is sized horizontally within auto layout.
+	LayoutSizingHorizontal figmatypes.LayoutSizing `json:"layoutSizingHorizontal,omitempty"`
+	// How the node is sized vertically within auto layout.
+	LayoutSizingVertical figmatypes.LayoutSizing `json:"layoutSizingVertical,omitempty"`
+	// Minimum width of the node within auto layout. default: null.
+	MinWidth *float64 `json:"minWidth,omitempty"`
+	// Maximum width of the node within auto layout. default: null.
+	MaxWidth *float64 `json:"maxWidth,omitempty"`
+	// Minimum height of the node within auto layout. default: null.
+	MinHeight *float64 `json:"minHeight,omitempty"`
+	// Maximum height of the node within auto layout. default: null.
+	MaxHeight *float64 `json:"maxHeight,omitempty"`
+}
+
+// GetInteractions returns the prototype interactions on the frame, falling back to legacy reactions.
+func (f *Frame) GetInteractions() []figmatypes.Interaction {
+	if len(f.Interactions) == 0 {
+		return f.Reactions
+	}
+	return f.Interactions
+}
+
+// GetTransitionNodeID returns the legacy prototype destination of the frame.
+func (f *Frame) GetTransitionNodeID() string {
+	return f.TransitionNodeID
+}
+
+// GetStyles returns the styles applied to the frame.
+func (f *Frame) GetStyles() map[string]string {
+	return f.Styles
+}
+
+// GetFills returns the fill paints of the frame.
+func (f *Frame) GetFills() []figmatypes.Paint {
+	return f.Fills
+}
+
+// GetStrokes returns the stroke paints of the frame.
+func (f *Frame) GetStrokes() []figmatypes.Paint {
+	return f.Strokes
+}
+
+// GetEffects returns the effects of the frame.
+func (f *Frame) GetEffects() []figmatypes.Effect {
+	return f.Effects
+}
+
+// GetLayoutGrids returns the layout grids of the frame.
+func (f *Frame) GetLayoutGrids() []figmatypes.LayoutGrid {
+	return f.LayoutGrids
+}
+
+// GetClipsContent reports whether the frame clips its children to its bounds.
+func (f *Frame) GetClipsContent() bool {
+	return f.ClipsContent
 }
 
 // Group is a logical grouping of nodes.
-type Group Frame
+type Group struct {
+	Frame
+}
+
+// TransformGroup is a group that applies a transform to its children.
+type TransformGroup struct {
+	Frame
+}
+
+// Section is a container used to organize the canvas.
+type Section struct {
+	ParentNodeBase
+	// Whether the contents of the section are visible.
+	SectionContentsHidden bool `json:"sectionContentsHidden,omitempty"`
+	// The Dev Mode status of the section.
+	DevStatus *figmatypes.DevStatus `json:"devStatus,omitempty"`
+	// An array of fill paints applied to the node.
+	Fills []figmatypes.Paint `json:"fills,omitempty"`
+	// An array of stroke paints applied to the node.
+	Strokes []figmatypes.Paint `json:"strokes,omitempty"`
+	// The weight of strokes on the node.
+	StrokeWeight float64 `json:"strokeWeight,omitempty"`
+	// Where stroke is drawn relative to the node outline.
+	StrokeAlign StrokeAlignType `json:"strokeAlign,omitempty"`
+	// Bounding box of the node in absolute space coordinates.
+	AbsoluteBoundingBox figmatypes.Rectangle `json:"absoluteBoundingBox,omitempty"`
+}
 
 type StrokeAlignType string
 
 const (
 	StrokeAlignTypeINSIDE  StrokeAlignType = "INSIDE"
-	StrokeAlignTypeOUTSIDE                 = "OUTSIDE"
-	StrokeAlignTypeCENTER                  = "CENTER"
+	StrokeAlignTypeOUTSIDE StrokeAlignType = "OUTSIDE"
+	StrokeAlignTypeCENTER  StrokeAlignType = "CENTER"
 )
 
 // Vector is a vector network, consisting of vertices and edges.
 type Vector struct {
 	NodeBase
 	// An array of export settings representing images to export from node
-	ExportSettings []figmatypes.ExportSetting `json:"exportSettings"`
+	ExportSettings []figmatypes.ExportSetting `json:"exportSettings,omitempty"`
 	// How this node blends with nodes behind it in the scene (see blend mode section for more details)
 	BlendMode figmatypes.BlendMode `json:"blendMode,omitempty"`
 	// Keep height and width constrained to same ratio default: false.
@@ -210,14 +576,18 @@ type Vector struct {
 	Constraints figmatypes.LayoutConstraint `json:"constraints,omitempty"`
 	//  ID of node to transition to in prototyping default: null.
 	TransitionNodeID string `json:"transitionNodeID,omitempty"`
+	// The prototype interactions on this node.
+	Interactions []figmatypes.Interaction `json:"interactions,omitempty"`
+	// The prototype interactions on this node, in their legacy form.
+	Reactions []figmatypes.Interaction `json:"reactions,omitempty"`
 	// Opacity of the node default: 1.
 	Opacity float64 `json:"opacity,omitempty"`
 	// Bounding box of the node in absolute space coordinates
 	AbsoluteBoundingBox figmatypes.Rectangle `json:"absoluteBoundingBox,omitempty"`
 	// Width and height of element. This is different from the width and height of the bounding box in that the absolute bounding box represents the element after scaling and rotation. Only present if geometry=paths is passed
-	Size *Vector `json:"size,omitempty"`
+	Size *figmatypes.Vector `json:"size,omitempty"`
 	// The top two rows of a matrix that represents the 2D transform of this node relative to its parent. The bottom row of the matrix is implicitly always (0, 0, 1). Use to transform coordinates in geometry. Only present if geometry=paths is passed
-	RelativeTransform figmatypes.Transform `json:"relativeTransform,omitempty"`
+	RelativeTransform *figmatypes.Transform `json:"relativeTransform,omitempty"`
 	// An array of effects attached to this node (see effects section for more details)
 	Effects []figmatypes.Effect `json:"effects"`
 	// Does this node mask sibling nodes in front of it? default: false.
@@ -234,11 +604,75 @@ type Vector struct {
 	StrokeGeometry []figmatypes.Path `json:"strokeGeometry,omitempty"`
 	// Where stroke is drawn relative to the vector outline as a string enum
 	StrokeAlign StrokeAlignType `json:"strokeAlign,omitempty"`
-	// A mapping of a StyleType to style ID (see Style) of styles present on this node. The style ID can be used to look up more information about the style in the top-level styles field.
-	Styles map[figmatypes.StyleType]string `json:"styles,omitempty"`
+	// The weight of strokes on each side of the node, if they differ.
+	IndividualStrokeWeights *figmatypes.StrokeWeights `json:"individualStrokeWeights,omitempty"`
+	// The decoration at the ends of open paths. default: NONE.
+	StrokeCap figmatypes.StrokeCap `json:"strokeCap,omitempty"`
+	// The decoration where stroke segments meet. default: MITER.
+	StrokeJoin figmatypes.StrokeJoin `json:"strokeJoin,omitempty"`
+	// Alternating lengths of dashes and gaps in a dashed stroke. Empty for solid strokes.
+	StrokeDashes []float64 `json:"strokeDashes,omitempty"`
+	// The angle in degrees below which a mitered join is beveled. default: 28.96.
+	StrokeMiterAngle float64 `json:"strokeMiterAngle,omitempty"`
+	// Bounds of the rendered node in absolute space coordinates, including effects and strokes. Null for nodes that are not rendered.
+	AbsoluteRenderBounds *figmatypes.Rectangle `json:"absoluteRenderBounds,omitempty"`
+	// A mapping of a lower-case style type, such as "fill", "text" or "effect", to the ID (see Style) of styles present on this node. The style ID can be used to look up more information about the style in the top-level styles field.
+	Styles map[string]string `json:"styles,omitempty"`
+	// How the node is aligned perpendicular to the layout direction of its auto layout parent.
+	LayoutAlign figmatypes.LayoutAlign `json:"layoutAlign,omitempty"`
+	// Whether the node stretches along the layout direction of its auto layout parent: 0 for fixed, 1 for stretch.
+	LayoutGrow float64 `json:"layoutGrow,omitempty"`
+	// Whether the node is positioned absolutely within its auto layout parent.
+	LayoutPositioning figmatypes.LayoutPositioning `json:"layoutPositioning,omitempty"`
+	// How the node is sized horizontally within auto layout.
+	LayoutSizingHorizontal figmatypes.LayoutSizing `json:"layoutSizingHorizontal,omitempty"`
+	// How the node is sized vertically within auto layout.
+	LayoutSizingVertical figmatypes.LayoutSizing `json:"layoutSizingVertical,omitempty"`
+	// Minimum width of the node within auto layout. default: null.
+	MinWidth *float64 `json:"minWidth,omitempty"`
+	// Maximum width of the node within auto layout. default: null.
+	MaxWidth *float64 `json:"maxWidth,omitempty"`
+	// Minimum height of the node within auto layout. default: null.
+	MinHeight *float64 `json:"minHeight,omitempty"`
+	// Maximum height of the node within auto layout. default: null.
+	MaxHeight *float64 `json:"maxHeight,omitempty"`
+}
+
+// GetInteractions returns the prototype interactions on the vector, falling back to legacy reactions.
+func (v *Vector) GetInteractions() []figmatypes.Interaction {
+	if len(v.Interactions) == 0 {
+		return v.Reactions
+	}
+	return v.Interactions
+}
+
+// GetTransitionNodeID returns the legacy prototype destination of the vector.
+func (v *Vector) GetTransitionNodeID() string {
+	return v.TransitionNodeID
+}
+
+// GetStyles returns the styles applied to the vector.
+func (v *Vector) GetStyles() map[string]string {
+	return v.Styles
+}
+
+// GetFills returns the fill paints of the vector.
+func (v *Vector) GetFills() []figmatypes.Paint {
+	return v.Fills
+}
+
+// GetStrokes returns the stroke paints of the vector.
+func (v *Vector) GetStrokes() []figmatypes.Paint {
+	return v.Strokes
+}
+
+// GetEffects returns the effects of the vector.
+func (v *Vector) GetEffects() []figmatypes.Effect {
+	return v.Effects
 }
 
 // Boolean is a group that has a boolean operation applied to it.
+// It is sent as either BOOLEAN or BOOLEAN_OPERATION.
 type Boolean struct {
 	Vector
 	Children Children `json:"children,omitempty"`
@@ -249,22 +683,34 @@ func (b *Boolean) GetChildren() Children {
 }
 
 // Star is a regular star shape.
-type Star Vector
+type Star struct {
+	Vector
+}
 
 // Line is a straight line.
-type Line Vector
+type Line struct {
+	Vector
+}
 
 // Ellipse is an ellipse.
-type Ellipse Vector
+type Ellipse struct {
+	Vector
+}
 
 // RegularPolygon is a regular n-sided polygon.
-type RegularPolygon Vector
+type RegularPolygon struct {
+	Vector
+}
 
 // Rectangle is a rectangle.
 type Rectangle struct {
 	Vector
 	// Radius of each corner of the rectangle.
 	CornerRadius float64 `json:"cornerRadius,omitempty"`
+	// Radii of the top left, top right, bottom right and bottom left corners, if they differ.
+	RectangleCornerRadii []float64 `json:"rectangleCornerRadii,omitempty"`
+	// How much corners are smoothed, from 0 for circular corners to 1 for iOS-style squircles.
+	CornerSmoothing float64 `json:"cornerSmoothing,omitempty"`
 }
 
 // Text is a text box.
@@ -280,25 +726,49 @@ type Text struct {
 	StyleOverrideTable map[int]figmatypes.TypeStyle `json:"styleOverrideTable"`
 }
 
+// TextPath is text laid out along a path.
+type TextPath struct {
+	Text
+}
+
 // Slice is a rectangular region of the canvas that can be exported.
 type Slice struct {
 	NodeBase
 	// An array of export settings representing images to export from this node
-	ExportSettings []figmatypes.ExportSetting `json:"exportSettings"`
+	ExportSettings []figmatypes.ExportSetting `json:"exportSettings,omitempty"`
 	// Bounding box of the node in absolute space coordinates
 	AbsoluteBoundingBox figmatypes.Rectangle `json:"absoluteBoundingBox,omitempty"`
 	// Width and height of element. This is different from the width and height of the bounding box in that the absolute bounding box represents the element after scaling and rotation. Only present if geometry=paths is passed
-	Size Vector `json:"size,omitempty"`
+	Size *figmatypes.Vector `json:"size,omitempty"`
 	// The top two rows of a matrix that represents the 2D transform of this node relative to its parent. The bottom row of the matrix is implicitly always (0, 0, 1). Use to transform coordinates in geometry. Only present if geometry=paths is passed
-	RelativeTransform figmatypes.Transform `json:"relativeTransform,omitempty"`
+	RelativeTransform *figmatypes.Transform `json:"relativeTransform,omitempty"`
 }
 
 // Component is a node that can have instances created of it that share the same properties.
-type Component Frame
+type Component struct {
+	Frame
+	// The properties defined on the component, keyed by property name. Empty for variants, whose properties are defined on their component set.
+	ComponentPropertyDefinitions map[string]figmatypes.ComponentPropertyDefinition `json:"componentPropertyDefinitions,omitempty"`
+}
+
+// ComponentSet is a set of variants of a component. Its children are the variant components.
+type ComponentSet struct {
+	Frame
+	// The properties defined on the component set, keyed by property name.
+	ComponentPropertyDefinitions map[string]figmatypes.ComponentPropertyDefinition `json:"componentPropertyDefinitions,omitempty"`
+}
 
 // Instance is an instance of a component, changes to the component result in the same changes applied to the instance.
 type Instance struct {
 	Frame
 	// ID of component that this instance came from, refers to components table.
 	ComponentID string `json:"componentId,omitempty"`
+	// The values of the component's properties on this instance, keyed by property name.
+	ComponentProperties map[string]figmatypes.ComponentProperty `json:"componentProperties,omitempty"`
+	// The nodes within this instance that have been overridden, and which of their fields.
+	Overrides []figmatypes.Overrides `json:"overrides,omitempty"`
+	// IDs of nested instances that are exposed to this instance's level.
+	ExposedInstances []string `json:"exposedInstances,omitempty"`
+	// Whether this instance has been exposed to its containing component.
+	IsExposedInstance bool `json:"isExposedInstance,omitempty"`
 }
@@ -0,0 +1,97 @@
+package nodes
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+const autoLayout = `[{
+	"id": "1:1",
+	"type": "FRAME",
+	"layoutMode": "HORIZONTAL",
+	"layoutWrap": "WRAP",
+	"primaryAxisSizingMode": "FIXED",
+	"counterAxisSizingMode": "AUTO",
+	"primaryAxisAlignItems": "SPACE_BETWEEN",
+	"counterAxisAlignItems": "CENTER",
+	"counterAxisAlignContent": "SPACE_BETWEEN",
+	"itemSpacing": 8,
+	"counterAxisSpacing": 12,
+	"paddingLeft": 16,
+	"paddingRight": 16,
+	"paddingTop": 4,
+	"paddingBottom": 4,
+	"itemReverseZIndex": true,
+	"strokesIncludedInLayout": true,
+	"minWidth": 120,
+	"maxWidth": 640,
+	"children": [
+		{
+			"id": "1:2",
+			"type": "RECTANGLE",
+			"layoutAlign": "STRETCH",
+			"layoutGrow": 1,
+			"layoutSizingHorizontal": "FILL",
+			"layoutSizingVertical": "HUG",
+			"minHeight": 0,
+			"maxHeight": 48
+		},
+		{
+			"id": "1:3",
+			"type": "ELLIPSE",
+			"layoutPositioning": "ABSOLUTE",
+			"layoutSizingHorizontal": "FIXED",
+			"layoutSizingVertical": "FIXED"
+		}
+	]
+}]`
+
+func TestAutoLayout(t *testing.T) {
+	var c Children
+	if err := json.Unmarshal([]byte(autoLayout), &c); err != nil {
+		t.Fatal(err)
+	}
+	f := c[0].(*Frame)
+	if f.LayoutMode != figmatypes.LayoutModeHORIZONTAL || f.LayoutWrap != figmatypes.LayoutWrapWRAP ||
+		f.PrimaryAxisSizingMode != figmatypes.AxisSizingModeFIXED || f.CounterAxisSizingMode != figmatypes.AxisSizingModeAUTO ||
+		f.PrimaryAxisAlignItems != figmatypes.PrimaryAxisAlignItemsSPACE_BETWEEN ||
+		f.CounterAxisAlignContent != figmatypes.CounterAxisAlignContentSPACE_BETWEEN {
+		t.Errorf("got layout %v %v, sizing %v %v, alignment %v %v",
+			f.LayoutMode, f.LayoutWrap, f.PrimaryAxisSizingMode, f.CounterAxisSizingMode,
+			f.PrimaryAxisAlignItems, f.CounterAxisAlignContent)
+	}
+	if f.ItemSpacing != 8 || f.CounterAxisSpacing != 12 || f.PaddingLeft != 16 || f.PaddingBottom != 4 {
+		t.Errorf("got spacing %v and %v, padding %v and %v", f.ItemSpacing, f.CounterAxisSpacing, f.PaddingLeft, f.PaddingBottom)
+	}
+	if f.MinWidth == nil || *f.MinWidth != 120 || f.MaxWidth == nil || *f.MaxWidth != 640 || f.MinHeight != nil {
+		t.Errorf("got width limits %v and %v, min height %v", f.MinWidth, f.MaxWidth, f.MinHeight)
+	}
+
+	fill := f.Children[0].(*Rectangle)
+	if fill.LayoutSizingHorizontal != figmatypes.LayoutSizingFILL || fill.LayoutGrow != 1 ||
+		fill.MinHeight == nil || *fill.MinHeight != 0 || fill.MaxHeight == nil || *fill.MaxHeight != 48 {
+		t.Errorf("got filling child %+v", fill)
+	}
+	badge := f.Children[1].(*Ellipse)
+	if badge.LayoutPositioning != figmatypes.LayoutPositioningABSOLUTE {
+		t.Errorf("got positioning %q, want %q", badge.LayoutPositioning, figmatypes.LayoutPositioningABSOLUTE)
+	}
+
+	out, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want, got interface{}
+	if err := json.Unmarshal([]byte(autoLayout), &want); err != nil {
+		t.Fatal(err)
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip differs:\ngot  %s\nwant %s", out, autoLayout)
+	}
+}
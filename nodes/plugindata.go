@@ -0,0 +1,134 @@
+package nodes
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+// PluginDataHolder is implemented by nodes that can carry plugin data.
+type PluginDataHolder interface {
+	GetPluginData(pluginID, key string) (string, bool)
+	GetSharedPluginData(namespace, key string) (string, bool)
+	PluginDataKeys(pluginID string) []string
+	SharedPluginDataKeys(namespace string) []string
+}
+
+// GetPluginData returns the value a plugin stored on the node under key.
+func (b *NodeBase) GetPluginData(pluginID, key string) (string, bool) {
+	v, ok := b.PluginData[pluginID][key]
+	return v, ok
+}
+
+// GetSharedPluginData returns the value stored on the node under namespace and key.
+func (b *NodeBase) GetSharedPluginData(namespace, key string) (string, bool) {
+	v, ok := b.SharedPluginData[namespace][key]
+	return v, ok
+}
+
+// PluginDataKeys returns the keys a plugin stored on the node, sorted.
+func (b *NodeBase) PluginDataKeys(pluginID string) []string {
+	return sortedKeys(b.PluginData[pluginID])
+}
+
+// SharedPluginDataKeys returns the keys stored on the node under namespace, sorted.
+func (b *NodeBase) SharedPluginDataKeys(namespace string) []string {
+	return sortedKeys(b.SharedPluginData[namespace])
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// SetSharedPluginData stores value on the node under namespace and key, as setSharedPluginData does in plugins.
+// An empty value removes the key.
+func (b *NodeBase) SetSharedPluginData(namespace, key, value string) {
+	if value == "" {
+		delete(b.SharedPluginData[namespace], key)
+		if len(b.SharedPluginData[namespace]) == 0 {
+			delete(b.SharedPluginData, namespace)
+		}
+		return
+	}
+	if b.SharedPluginData == nil {
+		b.SharedPluginData = make(map[string]map[string]string)
+	}
+	if b.SharedPluginData[namespace] == nil {
+		b.SharedPluginData[namespace] = make(map[string]string)
+	}
+	b.SharedPluginData[namespace][key] = value
+}
+
+// PluginDataError is returned when a node has no plugin data under a key.
+type PluginDataError struct {
+	NodeID string
+	// The plugin ID, or the namespace of shared plugin data.
+	Namespace string
+	Key       string
+}
+
+func (e *PluginDataError) Error() string {
+	return fmt.Sprintf("nodes: node %s has no plugin data %s/%s", e.NodeID, e.Namespace, e.Key)
+}
+
+// UnmarshalPluginData decodes the JSON a plugin stored on n under key into v.
+func UnmarshalPluginData(n Node, pluginID, key string, v interface{}) error {
+	h, ok := n.(PluginDataHolder)
+	if !ok {
+		return &PluginDataError{NodeID: n.GetID(), Namespace: pluginID, Key: key}
+	}
+	data, ok := h.GetPluginData(pluginID, key)
+	if !ok {
+		return &PluginDataError{NodeID: n.GetID(), Namespace: pluginID, Key: key}
+	}
+	return json.Unmarshal([]byte(data), v)
+}
+
+// UnmarshalSharedPluginData decodes the JSON stored on n under namespace and key into v.
+func UnmarshalSharedPluginData(n Node, namespace, key string, v interface{}) error {
+	h, ok := n.(PluginDataHolder)
+	if !ok {
+		return &PluginDataError{NodeID: n.GetID(), Namespace: namespace, Key: key}
+	}
+	data, ok := h.GetSharedPluginData(namespace, key)
+	if !ok {
+		return &PluginDataError{NodeID: n.GetID(), Namespace: namespace, Key: key}
+	}
+	return json.Unmarshal([]byte(data), v)
+}
+
+// FindWithPluginData returns the nodes under root on which the plugin stored all of keys,
+// or any data at all if no keys are given.
+func FindWithPluginData(root Node, pluginID string, keys ...string) []Node {
+	return FindAll(root, func(n Node) bool {
+		h, ok := n.(PluginDataHolder)
+		return ok && hasKeys(h.PluginDataKeys(pluginID), keys)
+	})
+}
+
+// FindWithSharedPluginData returns the nodes under root carrying all of keys under namespace,
+// or any data in the namespace if no keys are given.
+func FindWithSharedPluginData(root Node, namespace string, keys ...string) []Node {
+	return FindAll(root, func(n Node) bool {
+		h, ok := n.(PluginDataHolder)
+		return ok && hasKeys(h.SharedPluginDataKeys(namespace), keys)
+	})
+}
+
+// hasKeys reports whether the sorted keys are non-empty and include every key in want.
+func hasKeys(keys, want []string) bool {
+	if len(keys) == 0 {
+		return false
+	}
+	for _, k := range want {
+		if i := sort.SearchStrings(keys, k); i == len(keys) || keys[i] != k {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,48 @@
+package nodes
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestPluginData(t *testing.T) {
+	root := decodeNode(t, `{"id": "0:1", "type": "CANVAS", "children": [
+		{"id": "1:1", "type": "FRAME",
+			"pluginData": {"123": {"state": "draft"}},
+			"sharedPluginData": {"acme": {"ticket": "{\"id\": 42, \"owner\": \"design\"}", "reviewed": "true"}},
+			"children": [
+				{"id": "1:2", "type": "TEXT", "sharedPluginData": {"acme": {"ticket": "{\"id\": 7}"}}}
+			]}
+	]}`)
+	frame := root.(*Canvas).Children[0].(*Frame)
+
+	if v, ok := frame.GetPluginData("123", "state"); !ok || v != "draft" {
+		t.Errorf("GetPluginData = %q, %v", v, ok)
+	}
+	var ticket struct {
+		ID    int    `json:"id"`
+		Owner string `json:"owner"`
+	}
+	if err := UnmarshalSharedPluginData(frame, "acme", "ticket", &ticket); err != nil || ticket.ID != 42 || ticket.Owner != "design" {
+		t.Errorf("UnmarshalSharedPluginData = %+v, %v", ticket, err)
+	}
+	var pdErr *PluginDataError
+	if err := UnmarshalPluginData(frame, "123", "missing", &ticket); !errors.As(err, &pdErr) {
+		t.Errorf("missing key: got %v", err)
+	}
+
+	if got := FindWithSharedPluginData(root, "acme", "ticket"); len(got) != 2 {
+		t.Errorf("FindWithSharedPluginData(ticket) found %d nodes", len(got))
+	}
+	if got := FindWithSharedPluginData(root, "acme", "ticket", "reviewed"); len(got) != 1 || got[0].GetID() != "1:1" {
+		t.Errorf("FindWithSharedPluginData(ticket, reviewed) = %v", got)
+	}
+	if got := FindWithPluginData(root, "123"); len(got) != 1 {
+		t.Errorf("FindWithPluginData found %d nodes", len(got))
+	}
+
+	frame.SetSharedPluginData("acme", "reviewed", "")
+	if keys := frame.SharedPluginDataKeys("acme"); len(keys) != 1 || keys[0] != "ticket" {
+		t.Errorf("keys after removal = %v", keys)
+	}
+}
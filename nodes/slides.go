@@ -0,0 +1,23 @@
+package nodes
+
+// Slides Types
+
+// Slide is a single slide in a Figma Slides deck.
+type Slide struct {
+	Frame
+}
+
+// SlideRow is a row of slides in the slide grid.
+type SlideRow struct {
+	Frame
+}
+
+// SlideGrid is the grid holding every row of slides in a deck.
+type SlideGrid struct {
+	Frame
+}
+
+// InteractiveSlideElement is an interactive element, such as a poll, placed on a slide.
+type InteractiveSlideElement struct {
+	Vector
+}
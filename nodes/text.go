@@ -0,0 +1,60 @@
+package nodes
+
+import (
+	"unicode/utf16"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// TextRun is a range of characters of a text node sharing the same style.
+type TextRun struct {
+	// The characters of the run.
+	Text string
+	// The style of the run: the node's style merged with the run's override, if any.
+	Style figmatypes.TypeStyle
+	// The key of the run's entry in the style override table, or 0 for the node's style.
+	OverrideID int
+	// The range of the run in UTF-16 code units, the unit used by CharacterStyleOverrides
+	// and by text ranges elsewhere in the Figma API.
+	Start, End int
+}
+
+// Runs splits the characters of the text node into runs of consistent style.
+//
+// CharacterStyleOverrides is indexed by UTF-16 code unit and may be shorter than the
+// text, in which case the remaining characters use the node's style. Overrides missing
+// from StyleOverrideTable also fall back to the node's style.
+func (t *Text) Runs() []TextRun {
+	var (
+		runs  []TextRun
+		cur   = -1 // Override ID of the current run.
+		start int  // Byte offset of the current run.
+		from  int  // UTF-16 offset of the current run.
+		pos   int  // UTF-16 offset of the current character.
+	)
+	for i, r := range t.Characters {
+		id := 0
+		if pos < len(t.CharacterStyleOverrides) {
+			id = t.CharacterStyleOverrides[pos]
+		}
+		if id != cur {
+			if cur >= 0 {
+				runs = append(runs, t.run(t.Characters[start:i], cur, from, pos))
+			}
+			cur, start, from = id, i, pos
+		}
+		pos += max(utf16.RuneLen(r), 1)
+	}
+	if cur >= 0 {
+		runs = append(runs, t.run(t.Characters[start:], cur, from, pos))
+	}
+	return runs
+}
+
+func (t *Text) run(text string, id, start, end int) TextRun {
+	style := t.Style
+	if o, ok := t.StyleOverrideTable[id]; ok && id != 0 {
+		style = style.Merge(o)
+	}
+	return TextRun{Text: text, Style: style, OverrideID: id, Start: start, End: end}
+}
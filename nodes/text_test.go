@@ -0,0 +1,51 @@
+package nodes
+
+import (
+	"testing"
+)
+
+func TestRuns(t *testing.T) {
+	n := decodeNode(t, `{
+		"id": "1:1", "type": "TEXT",
+		"characters": "Hi 👋 bold link",
+		"style": {"fontFamily": "Inter", "fontWeight": 400, "italic": true, "letterSpacing": 0},
+		"characterStyleOverrides": [0, 0, 0, 0, 0, 0, 1, 1, 1, 1, 0, 2, 2, 2, 2],
+		"styleOverrideTable": {
+			"1": {"fontWeight": 700},
+			"2": {"italic": false, "hyperlink": {"type": "URL", "url": "https://example.com"}}
+		}
+	}`).(*Text)
+
+	runs := n.Runs()
+	want := []struct {
+		text       string
+		id         int
+		start, end int
+	}{
+		{"Hi 👋 ", 0, 0, 6},
+		{"bold", 1, 6, 10},
+		{" ", 0, 10, 11},
+		{"link", 2, 11, 15},
+	}
+	if len(runs) != len(want) {
+		t.Fatalf("got %d runs: %+v", len(runs), runs)
+	}
+	for i, w := range want {
+		r := runs[i]
+		if r.Text != w.text || r.OverrideID != w.id || r.Start != w.start || r.End != w.end {
+			t.Errorf("run %d = %q %d [%d,%d), want %q %d [%d,%d)", i, r.Text, r.OverrideID, r.Start, r.End, w.text, w.id, w.start, w.end)
+		}
+	}
+	if s := runs[1].Style; s.FontWeight != 700 || !s.Italic || s.FontFamily != "Inter" {
+		t.Errorf("bold run style = %+v", s)
+	}
+	if s := runs[3].Style; s.Italic || s.Hyperlink == nil || s.FontWeight != 400 {
+		t.Errorf("link run style = %+v", s)
+	}
+
+	// Overrides shorter than the text leave the rest in the node's style.
+	n.CharacterStyleOverrides = []int{1}
+	if runs := n.Runs(); len(runs) != 2 || runs[0].Text != "H" || runs[1].OverrideID != 0 {
+		t.Errorf("short overrides: %+v", runs)
+	}
+}
@@ -0,0 +1,151 @@
+package nodes
+
+import (
+	"math"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Transformed is implemented by nodes with a size and a transform relative to their containing parent.
+// Both are only present if geometry=paths was requested; GetRelativeTransform returns the zero
+// Transform for nodes without one.
+type Transformed interface {
+	GetRelativeTransform() figmatypes.Transform
+	GetSize() *figmatypes.Vector
+}
+
+// Bounded is implemented by nodes with a bounding box in absolute coordinates.
+type Bounded interface {
+	GetAbsoluteBoundingBox() figmatypes.Rectangle
+}
+
+// GetRelativeTransform returns the transform of the frame relative to its containing parent.
+func (f *Frame) GetRelativeTransform() figmatypes.Transform { return deref(f.RelativeTransform) }
+
+// GetSize returns the size of the frame.
+func (f *Frame) GetSize() *figmatypes.Vector { return f.Size }
+
+// GetAbsoluteBoundingBox returns the bounding box of the frame.
+func (f *Frame) GetAbsoluteBoundingBox() figmatypes.Rectangle { return f.AbsoluteBoundingBox }
+
+// GetRelativeTransform returns the transform of the vector relative to its containing parent.
+func (v *Vector) GetRelativeTransform() figmatypes.Transform { return deref(v.RelativeTransform) }
+
+// GetSize returns the size of the vector.
+func (v *Vector) GetSize() *figmatypes.Vector { return v.Size }
+
+// GetAbsoluteBoundingBox returns the bounding box of the vector.
+func (v *Vector) GetAbsoluteBoundingBox() figmatypes.Rectangle { return v.AbsoluteBoundingBox }
+
+// GetRelativeTransform returns the transform of the slice relative to its containing parent.
+func (s *Slice) GetRelativeTransform() figmatypes.Transform { return deref(s.RelativeTransform) }
+
+// GetSize returns the size of the slice.
+func (s *Slice) GetSize() *figmatypes.Vector { return s.Size }
+
+// GetAbsoluteBoundingBox returns the bounding box of the slice.
+func (s *Slice) GetAbsoluteBoundingBox() figmatypes.Rectangle { return s.AbsoluteBoundingBox }
+
+// GetAbsoluteBoundingBox returns the bounding box of the section.
+func (s *Section) GetAbsoluteBoundingBox() figmatypes.Rectangle { return s.AbsoluteBoundingBox }
+
+func deref(t *figmatypes.Transform) figmatypes.Transform {
+	if t == nil {
+		return figmatypes.Transform{}
+	}
+	return *t
+}
+
+// establishesSpace reports whether n is a containing parent: a node whose children are positioned relative to it.
+// Groups and boolean operations are not; their children are positioned relative to the group's own containing parent.
+func establishesSpace(n Node) bool {
+	switch n.(type) {
+	case *Group, *Boolean, *TransformGroup:
+		return false
+	}
+	return true
+}
+
+// AbsoluteTransform returns the transform from the local coordinate space of n to absolute canvas coordinates,
+// composing the relative transforms of its containing ancestors. path holds the ancestors of n as yielded by All.
+//
+// A node without a relative transform is placed using the position of its absolute bounding box, if it has one.
+// Documents and canvases use the identity transform.
+func AbsoluteTransform(path Path, n Node) figmatypes.Transform {
+	result := figmatypes.IdentityTransform
+	for i := len(path); ; i-- {
+		if i == len(path) || establishesSpace(n) {
+			t, absolute := localTransform(n)
+			result = t.Multiply(result)
+			if absolute {
+				return result
+			}
+		}
+		if i == 0 {
+			return result
+		}
+		n = path[i-1]
+	}
+}
+
+// localTransform returns the relative transform of n and whether it is already absolute.
+func localTransform(n Node) (figmatypes.Transform, bool) {
+	if t, ok := n.(Transformed); ok && !t.GetRelativeTransform().IsZero() {
+		return t.GetRelativeTransform(), false
+	}
+	if b, ok := n.(Bounded); ok {
+		r := b.GetAbsoluteBoundingBox()
+		return figmatypes.TranslateTransform(r.X, r.Y), true
+	}
+	return figmatypes.IdentityTransform, false
+}
+
+// AbsoluteTransforms returns the absolute transform of every node under root, keyed by node ID.
+func AbsoluteTransforms(root Node) map[string]figmatypes.Transform {
+	result := make(map[string]figmatypes.Transform)
+	for path, n := range All(root) {
+		result[n.GetID()] = AbsoluteTransform(path, n)
+	}
+	return result
+}
+
+// BoundingBoxMismatch is a node whose absolute bounding box differs from the one computed from its transforms.
+type BoundingBoxMismatch struct {
+	Node Node
+	// The bounding box sent by the API.
+	Want figmatypes.Rectangle
+	// The bounding box computed from the node's size and absolute transform.
+	Got figmatypes.Rectangle
+}
+
+// CheckBoundingBoxes computes the bounding box of every node under root that has a size and relative transform,
+// and returns those that differ from the node's absolute bounding box by more than tolerance.
+// Nodes without an absolute bounding box are skipped.
+func CheckBoundingBoxes(root Node, tolerance float64) []BoundingBoxMismatch {
+	var result []BoundingBoxMismatch
+	for path, n := range All(root) {
+		t, ok := n.(Transformed)
+		if !ok || t.GetSize() == nil || t.GetRelativeTransform().IsZero() {
+			continue
+		}
+		b, ok := n.(Bounded)
+		if !ok {
+			continue
+		}
+		size := t.GetSize()
+		got := AbsoluteTransform(path, n).ApplyRect(figmatypes.Rectangle{Width: size.X, Height: size.Y})
+		want := b.GetAbsoluteBoundingBox()
+		if want == (figmatypes.Rectangle{}) {
+			continue
+		}
+		if !near(got.X, want.X, tolerance) || !near(got.Y, want.Y, tolerance) ||
+			!near(got.Width, want.Width, tolerance) || !near(got.Height, want.Height, tolerance) {
+			result = append(result, BoundingBoxMismatch{Node: n, Want: want, Got: got})
+		}
+	}
+	return result
+}
+
+func near(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
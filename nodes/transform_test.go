@@ -0,0 +1,103 @@
+package nodes
+
+import (
+	"encoding/json"
+	"math"
+	"testing"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+func TestAbsoluteTransforms(t *testing.T) {
+	var c Children
+	err := json.Unmarshal([]byte(`[{
+		"id": "0:0", "type": "DOCUMENT", "children": [
+			{"id": "1:0", "type": "CANVAS", "children": [
+				{"id": "2:0", "type": "FRAME",
+					"relativeTransform": [[1, 0, 100], [0, 1, 50]], "size": {"x": 200, "y": 100},
+					"absoluteBoundingBox": {"x": 100, "y": 50, "width": 200, "height": 100},
+					"children": [
+						{"id": "3:0", "type": "GROUP",
+							"relativeTransform": [[1, 0, 20], [0, 1, 10]], "size": {"x": 10, "y": 20},
+							"absoluteBoundingBox": {"x": 120, "y": 60, "width": 10, "height": 20},
+							"children": [
+								{"id": "4:0", "type": "RECTANGLE",
+									"relativeTransform": [[0, -1, 30], [1, 0, 10]], "size": {"x": 20, "y": 10},
+									"absoluteBoundingBox": {"x": 120, "y": 60, "width": 10, "height": 20}},
+								{"id": "4:1", "type": "RECTANGLE",
+									"relativeTransform": [[1, 0, 0], [0, 1, 0]], "size": {"x": 5, "y": 5},
+									"absoluteBoundingBox": {"x": 0, "y": 0, "width": 5, "height": 5}}
+							]}
+					]},
+				{"id": "2:1", "type": "FRAME",
+					"absoluteBoundingBox": {"x": -40, "y": 8, "width": 10, "height": 10},
+					"children": [
+						{"id": "3:1", "type": "ELLIPSE",
+							"relativeTransform": [[1, 0, 1], [0, 1, 2]], "size": {"x": 4, "y": 4}}
+					]}
+			]}
+		]
+	}]`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	abs := AbsoluteTransforms(c[0])
+
+	tests := []struct {
+		id   string
+		want figmatypes.Transform
+	}{
+		{"0:0", figmatypes.IdentityTransform},
+		{"2:0", figmatypes.TranslateTransform(100, 50)},
+		// Children of groups are positioned relative to the group's containing frame.
+		{"4:0", figmatypes.Transform{{0, -1, 130}, {1, 0, 60}}},
+		// Frames without a relative transform fall back to their bounding box.
+		{"3:1", figmatypes.TranslateTransform(-39, 10)},
+	}
+	for _, tt := range tests {
+		got, ok := abs[tt.id]
+		if !ok {
+			t.Errorf("%s: no transform", tt.id)
+			continue
+		}
+		for i := range got {
+			for j := range got[i] {
+				if math.Abs(got[i][j]-tt.want[i][j]) > 1e-9 {
+					t.Errorf("%s: got %v, want %v", tt.id, got, tt.want)
+				}
+			}
+		}
+	}
+
+	mismatches := CheckBoundingBoxes(c[0], 0.01)
+	if len(mismatches) != 1 || mismatches[0].Node.GetID() != "4:1" {
+		t.Fatalf("got mismatches %+v, want only 4:1", mismatches)
+	}
+	if want := (figmatypes.Rectangle{X: 100, Y: 50, Width: 5, Height: 5}); mismatches[0].Got != want {
+		t.Errorf("got computed box %+v, want %+v", mismatches[0].Got, want)
+	}
+}
+
+func TestRelativeTransformEncoding(t *testing.T) {
+	f := &Frame{}
+	f.ID, f.Type = "1:1", NodeTypeFRAME
+	b, err := Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var m map[string]interface{}
+	if err := json.Unmarshal(b, &m); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := m["relativeTransform"]; ok {
+		t.Errorf("frame without a transform encoded one: %s", b)
+	}
+
+	var c Children
+	if err := json.Unmarshal([]byte(`[{"id":"1:1","type":"FRAME","relativeTransform":[[1,0,0],[0,1,0]]}]`), &c); err != nil {
+		t.Fatal(err)
+	}
+	if got := c[0].(Transformed).GetRelativeTransform(); got != figmatypes.IdentityTransform {
+		t.Errorf("got transform %v, want the identity", got)
+	}
+}
@@ -0,0 +1,132 @@
+package nodes
+
+import (
+	"errors"
+	"iter"
+)
+
+// SkipChildren is returned by a WalkFunc to skip the descendants of the current node.
+var SkipChildren = errors.New("skip children")
+
+// SkipAll is returned by a WalkFunc to stop the walk without error.
+var SkipAll = errors.New("skip all")
+
+// Path is the chain of ancestors of a node, from the root down to its parent.
+//
+// Paths passed to a WalkFunc or yielded by an iterator are reused as the traversal proceeds;
+// use Clone to retain one.
+type Path []Node
+
+// Parent returns the parent of the node the path leads to, or nil for the root.
+func (p Path) Parent() Node {
+	if len(p) == 0 {
+		return nil
+	}
+	return p[len(p)-1]
+}
+
+// Clone returns a copy of the path that is safe to retain.
+func (p Path) Clone() Path {
+	return append(Path(nil), p...)
+}
+
+// WalkFunc is called for each node visited by Walk, with the path of ancestors leading to it.
+//
+// Returning SkipChildren skips the node's descendants, returning SkipAll stops the walk,
+// and returning any other error stops the walk and is returned by Walk.
+type WalkFunc func(path Path, n Node) error
+
+// Walk visits root and its descendants in pre-order, calling fn for each.
+func Walk(root Node, fn WalkFunc) error {
+	return WalkDepth(root, -1, fn)
+}
+
+// WalkDepth is like Walk but does not descend more than maxDepth levels below root.
+// A maxDepth of 0 visits only root; a negative maxDepth is unlimited.
+func WalkDepth(root Node, maxDepth int, fn WalkFunc) error {
+	if root == nil {
+		return nil
+	}
+	err := walk(nil, root, maxDepth, fn)
+	if err == SkipAll || err == SkipChildren {
+		return nil
+	}
+	return err
+}
+
+func walk(path Path, n Node, depth int, fn WalkFunc) error {
+	if err := fn(path, n); err != nil {
+		if err == SkipChildren {
+			return nil
+		}
+		return err
+	}
+	if depth == 0 {
+		return nil
+	}
+	p, ok := n.(Parent)
+	if !ok {
+		return nil
+	}
+	path = append(path, n)
+	for _, c := range p.GetChildren() {
+		if err := walk(path, c, depth-1, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// All returns an iterator over root and its descendants in pre-order, yielding each node with the path leading to it.
+func All(root Node) iter.Seq2[Path, Node] {
+	return func(yield func(Path, Node) bool) {
+		Walk(root, func(path Path, n Node) error {
+			if !yield(path, n) {
+				return SkipAll
+			}
+			return nil
+		})
+	}
+}
+
+// PostOrder returns an iterator over root and its descendants in post-order: every node is yielded after its descendants.
+func PostOrder(root Node) iter.Seq2[Path, Node] {
+	return func(yield func(Path, Node) bool) {
+		if root != nil {
+			postOrder(nil, root, yield)
+		}
+	}
+}
+
+func postOrder(path Path, n Node, yield func(Path, Node) bool) bool {
+	if p, ok := n.(Parent); ok {
+		child := append(path, n)
+		for _, c := range p.GetChildren() {
+			if !postOrder(child, c, yield) {
+				return false
+			}
+		}
+	}
+	return yield(path, n)
+}
+
+// Find returns the first node in pre-order for which match returns true, or nil.
+func Find(root Node, match func(Node) bool) Node {
+	for _, n := range All(root) {
+		if match(n) {
+			return n
+		}
+	}
+	return nil
+}
+
+// FindAll returns all nodes in pre-order for which match returns true.
+func FindAll(root Node, match func(Node) bool) []Node {
+	var result []Node
+	for _, n := range All(root) {
+		if match(n) {
+			result = append(result, n)
+		}
+	}
+	return result
+}
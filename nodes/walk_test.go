@@ -0,0 +1,101 @@
+package nodes
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func testTree(t *testing.T) Node {
+	t.Helper()
+	var c Children
+	err := json.Unmarshal([]byte(`[{
+		"id": "0:0", "type": "DOCUMENT", "children": [
+			{"id": "1:0", "type": "CANVAS", "children": [
+				{"id": "2:0", "type": "FRAME", "children": [
+					{"id": "3:0", "type": "TEXT"},
+					{"id": "3:1", "type": "BOOLEAN_OPERATION", "children": [
+						{"id": "4:0", "type": "RECTANGLE"}
+					]}
+				]},
+				{"id": "2:1", "type": "FUTURE_CONTAINER", "children": [
+					{"id": "3:2", "type": "ELLIPSE"}
+				]}
+			]}
+		]
+	}]`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c[0]
+}
+
+func ids(path Path, n Node) string {
+	var parts []string
+	for _, p := range path {
+		parts = append(parts, p.GetID())
+	}
+	return strings.Join(append(parts, n.GetID()), "/")
+}
+
+func TestWalk(t *testing.T) {
+	root := testTree(t)
+	cases := []struct {
+		name  string
+		depth int
+		skip  string
+		stop  string
+		want  string
+	}{
+		{"all", -1, "", "", "0:0 0:0/1:0 0:0/1:0/2:0 0:0/1:0/2:0/3:0 0:0/1:0/2:0/3:1 0:0/1:0/2:0/3:1/4:0 0:0/1:0/2:1 0:0/1:0/2:1/3:2"},
+		{"depth", 2, "", "", "0:0 0:0/1:0 0:0/1:0/2:0 0:0/1:0/2:1"},
+		{"skip", -1, "2:0", "", "0:0 0:0/1:0 0:0/1:0/2:0 0:0/1:0/2:1 0:0/1:0/2:1/3:2"},
+		{"stop", -1, "", "3:1", "0:0 0:0/1:0 0:0/1:0/2:0 0:0/1:0/2:0/3:0 0:0/1:0/2:0/3:1"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			err := WalkDepth(root, tt.depth, func(path Path, n Node) error {
+				got = append(got, ids(path, n))
+				switch n.GetID() {
+				case tt.skip:
+					return SkipChildren
+				case tt.stop:
+					return SkipAll
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if s := strings.Join(got, " "); s != tt.want {
+				t.Errorf("got  %v\nwant %v", s, tt.want)
+			}
+		})
+	}
+}
+
+func TestIterators(t *testing.T) {
+	root := testTree(t)
+	var pre []string
+	for _, n := range All(root) {
+		pre = append(pre, n.GetID())
+		if n.GetID() == "3:1" {
+			break
+		}
+	}
+	if got := strings.Join(pre, " "); got != "0:0 1:0 2:0 3:0 3:1" {
+		t.Errorf("pre-order: got %v", got)
+	}
+	var post []string
+	for path, n := range PostOrder(root) {
+		post = append(post, ids(path, n))
+	}
+	want := "0:0/1:0/2:0/3:0 0:0/1:0/2:0/3:1/4:0 0:0/1:0/2:0/3:1 0:0/1:0/2:0 0:0/1:0/2:1/3:2 0:0/1:0/2:1 0:0/1:0 0:0"
+	if got := strings.Join(post, " "); got != want {
+		t.Errorf("post-order: got  %v\nwant %v", got, want)
+	}
+	if n := Find(root, func(n Node) bool { return n.GetType() == NodeTypeRECTANGLE }); n == nil || n.GetID() != "4:0" {
+		t.Errorf("Find: got %v", n)
+	}
+}
@@ -0,0 +1,2 @@
+// Package prototype builds the graph of prototype flows in a Figma file and renders it as Graphviz DOT or Mermaid.
+package prototype
@@ -0,0 +1,242 @@
+package prototype
+
+import (
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// Screen is a top level frame of a page: a frame, component, component set or instance
+// that is a direct child of a canvas or of a section on a canvas. Other top level nodes,
+// such as loose text and stickies, are annotations rather than screens.
+type Screen struct {
+	ID   string
+	Name string
+	// The page the screen is on.
+	PageID   string
+	PageName string
+	Node     nodes.Node
+	// DeadEnd is set for screens with no way to move on: no navigation to another screen and no back, close or URL action.
+	DeadEnd bool
+	// Unreachable is set for screens that no flow starting point leads to.
+	Unreachable bool
+}
+
+// Edge is a navigation between two screens.
+type Edge struct {
+	// Screen IDs of the origin and destination.
+	From, To string
+	// ID of the node carrying the interaction.
+	SourceNodeID string
+	// The trigger of the interaction. Empty for legacy transitions.
+	Trigger figmatypes.TriggerType
+	// How the destination is presented.
+	Navigation figmatypes.Navigation
+	// The animation, if any.
+	Transition *figmatypes.Transition
+}
+
+// Flow is a named starting point of a prototype.
+type Flow struct {
+	Name string
+	// Screen ID of the first screen.
+	StartID string
+}
+
+// Graph is the prototype flow graph of a file.
+type Graph struct {
+	// Screens in document order.
+	Screens []*Screen
+	// Edges in document order, without duplicates.
+	Edges []Edge
+	// Flows defined on the file's canvases.
+	Flows []Flow
+	// Flows whose starting point is not on any screen, such as a deleted frame.
+	// They are left out of Flows and of the rendered graph.
+	DanglingFlows []Flow
+
+	byID map[string]*Screen
+}
+
+// Screen returns the screen with the given ID.
+func (g *Graph) Screen(id string) (*Screen, bool) {
+	s, ok := g.byID[id]
+	return s, ok
+}
+
+// DeadEnds returns the screens that have no way to move on.
+func (g *Graph) DeadEnds() []*Screen {
+	var result []*Screen
+	for _, s := range g.Screens {
+		if s.DeadEnd {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// Unreachable returns the screens that no flow leads to.
+func (g *Graph) Unreachable() []*Screen {
+	var result []*Screen
+	for _, s := range g.Screens {
+		if s.Unreachable {
+			result = append(result, s)
+		}
+	}
+	return result
+}
+
+// New builds the prototype graph of a file.
+func New(f *figma.File) *Graph {
+	b := &builder{
+		g:       &Graph{byID: make(map[string]*Screen)},
+		screens: make(map[string]string),
+		exits:   make(map[string]bool),
+		seen:    make(map[Edge]bool),
+	}
+	for _, n := range f.Document.Children {
+		if c, ok := n.(*nodes.Canvas); ok {
+			b.canvas(c)
+		}
+	}
+	b.link()
+	return b.g
+}
+
+type builder struct {
+	g *Graph
+	// maps every node ID to the ID of the screen containing it.
+	screens map[string]string
+	// screens that have a back, close or URL action.
+	exits map[string]bool
+	seen  map[Edge]bool
+	// interactions in document order, resolved once every screen is known.
+	pending []pending
+}
+
+type pending struct {
+	screenID string
+	source   nodes.Node
+}
+
+func (b *builder) canvas(c *nodes.Canvas) {
+	for _, fp := range c.FlowStartingPoints {
+		b.g.Flows = append(b.g.Flows, Flow{Name: fp.Name, StartID: fp.NodeID})
+	}
+	if c.PrototypeStartNodeID != "" && len(c.FlowStartingPoints) == 0 {
+		b.g.Flows = append(b.g.Flows, Flow{Name: c.Name, StartID: c.PrototypeStartNodeID})
+	}
+	b.topLevel(c, c.Children)
+}
+
+func (b *builder) topLevel(c *nodes.Canvas, children nodes.Children) {
+	for _, n := range children {
+		switch n := n.(type) {
+		case *nodes.Section:
+			b.topLevel(c, n.Children)
+			continue
+		case *nodes.Frame, *nodes.Component, *nodes.ComponentSet, *nodes.Instance:
+		default:
+			continue
+		}
+		s := &Screen{
+			ID:       n.GetID(),
+			Name:     n.GetName(),
+			PageID:   c.ID,
+			PageName: c.Name,
+			Node:     n,
+		}
+		b.g.Screens = append(b.g.Screens, s)
+		b.g.byID[s.ID] = s
+		b.collect(s.ID, n)
+	}
+}
+
+func (b *builder) collect(screenID string, screen nodes.Node) {
+	for _, n := range nodes.All(screen) {
+		b.screens[n.GetID()] = screenID
+		if _, ok := n.(nodes.Interactive); ok {
+			b.pending = append(b.pending, pending{screenID: screenID, source: n})
+		}
+	}
+}
+
+func (b *builder) link() {
+	for _, p := range b.pending {
+		i := p.source.(nodes.Interactive)
+		if id := i.GetTransitionNodeID(); id != "" {
+			b.edge(Edge{From: p.screenID, SourceNodeID: p.source.GetID(), Navigation: figmatypes.NavigationNAVIGATE}, id)
+		}
+		for _, in := range i.GetInteractions() {
+			var trigger figmatypes.TriggerType
+			if in.Trigger != nil {
+				trigger = in.Trigger.Type
+			}
+			for _, a := range in.AllActions() {
+				switch a.Type {
+				case figmatypes.ActionTypeBACK, figmatypes.ActionTypeCLOSE, figmatypes.ActionTypeURL:
+					b.exits[p.screenID] = true
+				case figmatypes.ActionTypeNODE:
+					b.edge(Edge{
+						From:         p.screenID,
+						SourceNodeID: p.source.GetID(),
+						Trigger:      trigger,
+						Navigation:   a.Navigation,
+						Transition:   a.Transition,
+					}, a.Destination())
+				}
+			}
+		}
+	}
+
+	out := make(map[string]bool)
+	adj := make(map[string][]string)
+	for _, e := range b.g.Edges {
+		if e.From != e.To {
+			out[e.From] = true
+		}
+		adj[e.From] = append(adj[e.From], e.To)
+	}
+	reached := make(map[string]bool)
+	var visit func(id string)
+	visit = func(id string) {
+		if reached[id] {
+			return
+		}
+		reached[id] = true
+		for _, to := range adj[id] {
+			visit(to)
+		}
+	}
+	flows := b.g.Flows[:0]
+	for _, f := range b.g.Flows {
+		s, ok := b.screens[f.StartID]
+		if !ok {
+			b.g.DanglingFlows = append(b.g.DanglingFlows, f)
+			continue
+		}
+		f.StartID = s
+		flows = append(flows, f)
+		visit(s)
+	}
+	b.g.Flows = flows
+	for _, s := range b.g.Screens {
+		s.DeadEnd = !out[s.ID] && !b.exits[s.ID]
+		s.Unreachable = !reached[s.ID]
+	}
+}
+
+func (b *builder) edge(e Edge, destination string) {
+	to, ok := b.screens[destination]
+	if !ok {
+		return
+	}
+	e.To = to
+	key := e
+	key.SourceNodeID, key.Transition = "", nil
+	if b.seen[key] {
+		return
+	}
+	b.seen[key] = true
+	b.g.Edges = append(b.g.Edges, e)
+}
@@ -0,0 +1,89 @@
+package prototype
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma"
+)
+
+const file = `{
+  "document": {
+    "id": "0:0",
+    "type": "DOCUMENT",
+    "children": [{
+      "id": "0:1",
+      "name": "Page",
+      "type": "CANVAS",
+      "flowStartingPoints": [{"nodeId": "1:1", "name": "Checkout"}, {"nodeId": "9:9", "name": "Deleted"}],
+      "children": [
+        {"id": "1:1", "name": "Cart", "type": "FRAME", "children": [
+          {"id": "1:10", "name": "Pay", "type": "INSTANCE", "interactions": [
+            {"trigger": {"type": "ON_CLICK"}, "actions": [{"type": "NODE", "destinationId": "2:1", "navigation": "NAVIGATE", "transition": {"type": "DISSOLVE", "duration": 0.3}}]}
+          ]}
+        ]},
+        {"id": "2:1", "name": "Receipt", "type": "FRAME", "transitionNodeID": "1:1"},
+        {"id": "3:0", "name": "Archive", "type": "SECTION", "children": [
+          {"id": "3:1", "name": "Old \"cart\"", "type": "FRAME", "interactions": [
+            {"trigger": {"type": "ON_CLICK"}, "actions": [{"type": "BACK"}]}
+          ]},
+          {"id": "3:2", "name": "Help", "type": "FRAME"},
+          {"id": "3:3", "name": "Note", "type": "TEXT", "characters": "Old flow, keep for reference"}
+        ]},
+        {"id": "4:1", "name": "Arrow", "type": "VECTOR"}
+      ]
+    }]
+  }
+}`
+
+func TestGraph(t *testing.T) {
+	f := &figma.File{}
+	if err := json.Unmarshal([]byte(file), f); err != nil {
+		t.Fatal(err)
+	}
+	g := New(f)
+	if len(g.Screens) != 4 {
+		t.Fatalf("got %d screens, want 4", len(g.Screens))
+	}
+	if len(g.Edges) != 2 {
+		t.Fatalf("got edges %+v", g.Edges)
+	}
+	if e := g.Edges[0]; e.From != "1:1" || e.To != "2:1" || e.Trigger != "ON_CLICK" || e.SourceNodeID != "1:10" {
+		t.Errorf("got edge %+v", e)
+	}
+	var deadEnds, unreachable []string
+	for _, s := range g.DeadEnds() {
+		deadEnds = append(deadEnds, s.ID)
+	}
+	for _, s := range g.Unreachable() {
+		unreachable = append(unreachable, s.ID)
+	}
+	if got := strings.Join(deadEnds, ","); got != "3:2" {
+		t.Errorf("got dead ends %v, want 3:2", got)
+	}
+	if got := strings.Join(unreachable, ","); got != "3:1,3:2" {
+		t.Errorf("got unreachable %v, want 3:1,3:2", got)
+	}
+
+	dot := g.DOT()
+	for _, want := range []string{`"1:1" -> "2:1" [label="ON_CLICK"]`, `flow0 -> "1:1"`, `"3:1" [label="Old \"cart\"", fontcolor=gray, color=gray, style="dashed"]`,
+		`"3:2" [label="Help", fillcolor="#f8d7da", fontcolor=gray, color=gray, style="filled,dashed"]`} {
+		if !strings.Contains(dot, want) {
+			t.Errorf("DOT output missing %q:\n%s", want, dot)
+		}
+	}
+	if len(g.Flows) != 1 || len(g.DanglingFlows) != 1 || g.DanglingFlows[0].Name != "Deleted" {
+		t.Errorf("got flows %+v and dangling flows %+v", g.Flows, g.DanglingFlows)
+	}
+	if strings.Contains(dot, "9:9") || strings.Contains(dot, "flow1") {
+		t.Errorf("DOT output has an edge to a missing start node:\n%s", dot)
+	}
+
+	mermaid := g.Mermaid()
+	for _, want := range []string{`n1_1 -->|"ON_CLICK"| n2_1`, `n3_1["Old #quot;cart#quot;"]`, "class n3_2 deadEnd", "class n3_1,n3_2 unreachable"} {
+		if !strings.Contains(mermaid, want) {
+			t.Errorf("Mermaid output missing %q:\n%s", want, mermaid)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package prototype
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// DOT returns the graph in Graphviz DOT format.
+// Dead ends are filled red and unreachable screens are drawn dashed and gray.
+func (g *Graph) DOT() string {
+	buf := new(bytes.Buffer)
+	g.WriteDOT(buf)
+	return buf.String()
+}
+
+// WriteDOT writes the graph in Graphviz DOT format.
+func (g *Graph) WriteDOT(w io.Writer) error {
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "digraph prototype {")
+	fmt.Fprintln(buf, "  rankdir=LR;")
+	fmt.Fprintln(buf, "  node [shape=box];")
+	for _, s := range g.Screens {
+		// Graphviz keeps the last of repeated attributes, so a screen
+		// that is both a dead end and unreachable gets each one once.
+		var styles []string
+		attrs := []string{"label=" + strconv.Quote(s.Name)}
+		if s.DeadEnd {
+			styles = append(styles, "filled")
+			attrs = append(attrs, `fillcolor="#f8d7da"`)
+		}
+		if s.Unreachable {
+			styles = append(styles, "dashed")
+			attrs = append(attrs, `fontcolor=gray`, `color=gray`)
+		} else if s.DeadEnd {
+			attrs = append(attrs, `color="#c0392b"`)
+		}
+		if len(styles) > 0 {
+			attrs = append(attrs, "style="+strconv.Quote(strings.Join(styles, ",")))
+		}
+		fmt.Fprintf(buf, "  %s [%s];\n", strconv.Quote(s.ID), strings.Join(attrs, ", "))
+	}
+	for i, f := range g.Flows {
+		start := fmt.Sprintf("flow%d", i)
+		fmt.Fprintf(buf, "  %s [label=%s, shape=circle, style=filled, fillcolor=\"#d4edda\"];\n", start, strconv.Quote(f.Name))
+		fmt.Fprintf(buf, "  %s -> %s;\n", start, strconv.Quote(f.StartID))
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(buf, "  %s -> %s", strconv.Quote(e.From), strconv.Quote(e.To))
+		if l := e.label(); l != "" {
+			fmt.Fprintf(buf, " [label=%s]", strconv.Quote(l))
+		}
+		fmt.Fprintln(buf, ";")
+	}
+	fmt.Fprintln(buf, "}")
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+// Mermaid returns the graph as a Mermaid flowchart.
+// Dead ends are styled with the deadEnd class and unreachable screens with the unreachable class.
+func (g *Graph) Mermaid() string {
+	buf := new(bytes.Buffer)
+	g.WriteMermaid(buf)
+	return buf.String()
+}
+
+// WriteMermaid writes the graph as a Mermaid flowchart.
+func (g *Graph) WriteMermaid(w io.Writer) error {
+	buf := new(bytes.Buffer)
+	fmt.Fprintln(buf, "flowchart LR")
+	var deadEnds, unreachable []string
+	for _, s := range g.Screens {
+		fmt.Fprintf(buf, "  %s[%s]\n", mermaidID(s.ID), mermaidLabel(s.Name))
+		if s.DeadEnd {
+			deadEnds = append(deadEnds, mermaidID(s.ID))
+		}
+		if s.Unreachable {
+			unreachable = append(unreachable, mermaidID(s.ID))
+		}
+	}
+	for i, f := range g.Flows {
+		start := fmt.Sprintf("flow%d", i)
+		fmt.Fprintf(buf, "  %s((%s)) --> %s\n", start, mermaidLabel(f.Name), mermaidID(f.StartID))
+	}
+	for _, e := range g.Edges {
+		if l := e.label(); l != "" {
+			fmt.Fprintf(buf, "  %s -->|%s| %s\n", mermaidID(e.From), mermaidLabel(l), mermaidID(e.To))
+		} else {
+			fmt.Fprintf(buf, "  %s --> %s\n", mermaidID(e.From), mermaidID(e.To))
+		}
+	}
+	fmt.Fprintln(buf, "  classDef deadEnd fill:#f8d7da,stroke:#c0392b")
+	fmt.Fprintln(buf, "  classDef unreachable stroke-dasharray:5 5,color:#999")
+	if len(deadEnds) > 0 {
+		fmt.Fprintf(buf, "  class %s deadEnd\n", strings.Join(deadEnds, ","))
+	}
+	if len(unreachable) > 0 {
+		fmt.Fprintf(buf, "  class %s unreachable\n", strings.Join(unreachable, ","))
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
+
+func (e Edge) label() string {
+	var parts []string
+	if e.Trigger != "" {
+		parts = append(parts, string(e.Trigger))
+	}
+	if e.Navigation != "" && e.Navigation != "NAVIGATE" {
+		parts = append(parts, string(e.Navigation))
+	}
+	return strings.Join(parts, " ")
+}
+
+// mermaidID turns a node ID such as "1:2" into a Mermaid identifier.
+func mermaidID(id string) string {
+	return "n" + strings.NewReplacer(":", "_", ";", "__", "-", "_").Replace(id)
+}
+
+func mermaidLabel(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, "#quot;") + `"`
+}
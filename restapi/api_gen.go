@@ -0,0 +1,1132 @@
+// Code generated by apigen. DO NOT EDIT.
+
+package restapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+)
+
+// Node is implemented by every node type.
+type Node interface {
+	GetID() string
+	GetName() string
+	GetType() NodeType
+	GetVisible() *bool
+}
+
+// Parent is implemented by nodes that have children.
+type Parent interface {
+	Node
+	GetChildren() Children
+}
+
+// Children is a list of nodes of any type. It decodes each node into the
+// struct for its type.
+type Children []Node
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (c *Children) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	children := make(Children, 0, len(raw))
+	for _, r := range raw {
+		n, err := unmarshalNode(r)
+		if err != nil {
+			return err
+		}
+		children = append(children, n)
+	}
+	*c = children
+	return nil
+}
+
+// AnyNode holds a single node of any type.
+type AnyNode struct {
+	Node
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (a *AnyNode) UnmarshalJSON(data []byte) (err error) {
+	a.Node, err = unmarshalNode(data)
+	return err
+}
+
+// MarshalJSON implements json.Marshaler.
+func (a AnyNode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(a.Node)
+}
+
+// UnknownNode holds a node of a type that is not in the specification. It
+// encodes back to the JSON it was decoded from.
+type UnknownNode struct {
+	ID      string          `json:"id"`
+	Name    string          `json:"name"`
+	Type    NodeType        `json:"type"`
+	Visible *bool           `json:"visible,omitempty"`
+	Raw     json.RawMessage `json:"-"`
+}
+
+// GetID returns the ID of the node.
+func (n *UnknownNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *UnknownNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *UnknownNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *UnknownNode) GetVisible() *bool { return n.Visible }
+
+// MarshalJSON implements json.Marshaler.
+func (n *UnknownNode) MarshalJSON() ([]byte, error) {
+	if n.Raw != nil {
+		return n.Raw, nil
+	}
+	type plain UnknownNode
+	return json.Marshal((*plain)(n))
+}
+
+func unmarshalNode(data []byte) (Node, error) {
+	var head struct {
+		Type NodeType `json:"type"`
+	}
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, err
+	}
+	n := New(head.Type)
+	if n == nil {
+		n = &UnknownNode{Raw: append(json.RawMessage(nil), data...)}
+	}
+	if err := json.Unmarshal(data, n); err != nil {
+		return nil, fmt.Errorf("decoding %s node: %w", head.Type, err)
+	}
+	return n, nil
+}
+
+// NodeType is the type of a node.
+type NodeType string
+
+const (
+	NodeTypeCANVAS    NodeType = "CANVAS"
+	NodeTypeCOMPONENT NodeType = "COMPONENT"
+	NodeTypeDOCUMENT  NodeType = "DOCUMENT"
+	NodeTypeFRAME     NodeType = "FRAME"
+	NodeTypeGROUP     NodeType = "GROUP"
+	NodeTypeINSTANCE  NodeType = "INSTANCE"
+	NodeTypeRECTANGLE NodeType = "RECTANGLE"
+	NodeTypeTEXT      NodeType = "TEXT"
+)
+
+// New returns an empty node of type t, or nil if t is not a known node type.
+func New(t NodeType) Node {
+	switch t {
+	case NodeTypeCANVAS:
+		return &CanvasNode{Type: t}
+	case NodeTypeCOMPONENT:
+		return &ComponentNode{Type: t}
+	case NodeTypeDOCUMENT:
+		return &DocumentNode{Type: t}
+	case NodeTypeFRAME:
+		return &FrameNode{Type: t}
+	case NodeTypeGROUP:
+		return &GroupNode{Type: t}
+	case NodeTypeINSTANCE:
+		return &InstanceNode{Type: t}
+	case NodeTypeRECTANGLE:
+		return &RectangleNode{Type: t}
+	case NodeTypeTEXT:
+		return &TextNode{Type: t}
+	}
+	return nil
+}
+
+// BlendMode: Enum describing how layer blends with layers below.
+type BlendMode string
+
+const (
+	BlendModePASS_THROUGH BlendMode = "PASS_THROUGH"
+	BlendModeNORMAL       BlendMode = "NORMAL"
+	BlendModeDARKEN       BlendMode = "DARKEN"
+	BlendModeMULTIPLY     BlendMode = "MULTIPLY"
+	BlendModeLINEAR_BURN  BlendMode = "LINEAR_BURN"
+	BlendModeCOLOR_BURN   BlendMode = "COLOR_BURN"
+	BlendModeLIGHTEN      BlendMode = "LIGHTEN"
+	BlendModeSCREEN       BlendMode = "SCREEN"
+	BlendModeLINEAR_DODGE BlendMode = "LINEAR_DODGE"
+	BlendModeCOLOR_DODGE  BlendMode = "COLOR_DODGE"
+	BlendModeOVERLAY      BlendMode = "OVERLAY"
+	BlendModeSOFT_LIGHT   BlendMode = "SOFT_LIGHT"
+	BlendModeHARD_LIGHT   BlendMode = "HARD_LIGHT"
+	BlendModeDIFFERENCE   BlendMode = "DIFFERENCE"
+	BlendModeEXCLUSION    BlendMode = "EXCLUSION"
+	BlendModeHUE          BlendMode = "HUE"
+	BlendModeSATURATION   BlendMode = "SATURATION"
+	BlendModeCOLOR        BlendMode = "COLOR"
+	BlendModeLUMINOSITY   BlendMode = "LUMINOSITY"
+)
+
+// CanvasNode: A single page of a document.
+type CanvasNode struct {
+	// A string uniquely identifying this node within the document.
+	ID string `json:"id"`
+	// The name given to the node by the user in the tool.
+	Name string `json:"name"`
+	// The type of this node, represented by the string literal "CANVAS".
+	Type NodeType `json:"type"`
+	// Whether or not the node is visible on the canvas.
+	Visible *bool `json:"visible,omitempty"`
+	// If true, layer is locked and cannot be edited.
+	Locked *bool `json:"locked,omitempty"`
+	// A mapping of a layer's property to component property name of component
+	// properties attached to this node.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	// An array of nodes that are direct children of this node.
+	Children        Children `json:"children"`
+	BackgroundColor RGBA     `json:"backgroundColor"`
+	// Node ID that corresponds to the start frame for prototypes.
+	PrototypeStartNodeID string `json:"prototypeStartNodeID,omitempty"`
+}
+
+// Component: A description of a main component.
+type Component struct {
+	// The key of the component.
+	Key string `json:"key"`
+	// Name of the component.
+	Name string `json:"name"`
+	// The description of the component as entered in the editor.
+	Description string `json:"description"`
+	// The ID of the component set if the component belongs to one.
+	ComponentSetID string `json:"componentSetId,omitempty"`
+	// Whether this component is a remote component that doesn't live in this
+	// file.
+	Remote bool `json:"remote"`
+}
+
+// ComponentNode: A node that can have instances created of it that share the
+// same properties.
+type ComponentNode struct {
+	// A string uniquely identifying this node within the document.
+	ID string `json:"id"`
+	// The name given to the node by the user in the tool.
+	Name string `json:"name"`
+	// The type of this node, represented by the string literal "COMPONENT".
+	Type NodeType `json:"type"`
+	// Whether or not the node is visible on the canvas.
+	Visible *bool `json:"visible,omitempty"`
+	// If true, layer is locked and cannot be edited.
+	Locked *bool `json:"locked,omitempty"`
+	// A mapping of a layer's property to component property name of component
+	// properties attached to this node.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	// An array of nodes that are direct children of this node.
+	Children  Children  `json:"children"`
+	BlendMode BlendMode `json:"blendMode"`
+	// Opacity of the node.
+	Opacity              *float64   `json:"opacity,omitempty"`
+	AbsoluteBoundingBox  Rectangle  `json:"absoluteBoundingBox"`
+	AbsoluteRenderBounds *Rectangle `json:"absoluteRenderBounds,omitempty"`
+	RelativeTransform    Transform  `json:"relativeTransform,omitempty"`
+	Size                 *Vector    `json:"size,omitempty"`
+	// An array of fill paints applied to the node.
+	Fills []Paint `json:"fills"`
+	// A mapping of a StyleType to style ID of styles present on this node.
+	Styles map[string]string `json:"styles,omitempty"`
+	// An array of stroke paints applied to the node.
+	Strokes []Paint `json:"strokes,omitempty"`
+	// The weight of strokes on the node.
+	StrokeWeight *float64 `json:"strokeWeight,omitempty"`
+	// Position of stroke relative to vector outline.
+	StrokeAlign StrokeAlign `json:"strokeAlign,omitempty"`
+	// Radius of each corner if a single radius is set for all corners.
+	CornerRadius *float64 `json:"cornerRadius,omitempty"`
+	// A value that lets you control how "smooth" the corners are.
+	CornerSmoothing *float64 `json:"cornerSmoothing,omitempty"`
+	// Array of length 4 of the radius of each corner of the frame, starting in
+	// the top left and proceeding clockwise.
+	RectangleCornerRadii []float64 `json:"rectangleCornerRadii,omitempty"`
+	// Whether or not this node clip content outside of its bounds.
+	ClipsContent bool `json:"clipsContent"`
+	// Whether this layer uses auto-layout to position its children.
+	LayoutMode LayoutMode `json:"layoutMode,omitempty"`
+	// The distance between children of the frame.
+	ItemSpacing *float64 `json:"itemSpacing,omitempty"`
+	// The padding between the left border of the frame and its children.
+	PaddingLeft *float64 `json:"paddingLeft,omitempty"`
+	// The padding between the right border of the frame and its children.
+	PaddingRight *float64 `json:"paddingRight,omitempty"`
+	// The padding between the top border of the frame and its children.
+	PaddingTop *float64 `json:"paddingTop,omitempty"`
+	// The padding between the bottom border of the frame and its children.
+	PaddingBottom *float64 `json:"paddingBottom,omitempty"`
+	// A mapping of name to ComponentPropertyDefinition for every component
+	// property on this component.
+	ComponentPropertyDefinitions map[string]ComponentPropertyDefinition `json:"componentPropertyDefinitions,omitempty"`
+}
+
+// ComponentPropertyDefinition: A property of a component.
+type ComponentPropertyDefinition struct {
+	Type ComponentPropertyType `json:"type"`
+	// Initial value of this property for instances.
+	DefaultValue json.RawMessage `json:"defaultValue"`
+	// All possible values for this property. Only exists on VARIANT properties.
+	VariantOptions []string `json:"variantOptions,omitempty"`
+}
+
+// ComponentPropertyType: Component property type.
+type ComponentPropertyType string
+
+const (
+	ComponentPropertyTypeBOOLEAN       ComponentPropertyType = "BOOLEAN"
+	ComponentPropertyTypeINSTANCE_SWAP ComponentPropertyType = "INSTANCE_SWAP"
+	ComponentPropertyTypeTEXT          ComponentPropertyType = "TEXT"
+	ComponentPropertyTypeVARIANT       ComponentPropertyType = "VARIANT"
+)
+
+// DocumentNode: The root node of a document.
+type DocumentNode struct {
+	// A string uniquely identifying this node within the document.
+	ID string `json:"id"`
+	// The name given to the node by the user in the tool.
+	Name string `json:"name"`
+	// The type of this node, represented by the string literal "DOCUMENT".
+	Type NodeType `json:"type"`
+	// Whether or not the node is visible on the canvas.
+	Visible *bool `json:"visible,omitempty"`
+	// If true, layer is locked and cannot be edited.
+	Locked *bool `json:"locked,omitempty"`
+	// A mapping of a layer's property to component property name of component
+	// properties attached to this node.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	// The pages of the document.
+	Children []CanvasNode `json:"children"`
+}
+
+// EditorType: The type of editor associated with this file.
+type EditorType string
+
+const (
+	EditorTypeFIGMA  EditorType = "figma"
+	EditorTypeFIGJAM EditorType = "figjam"
+)
+
+// FrameNode: A frame.
+type FrameNode struct {
+	// A string uniquely identifying this node within the document.
+	ID string `json:"id"`
+	// The name given to the node by the user in the tool.
+	Name string `json:"name"`
+	// The type of this node, represented by the string literal "FRAME".
+	Type NodeType `json:"type"`
+	// Whether or not the node is visible on the canvas.
+	Visible *bool `json:"visible,omitempty"`
+	// If true, layer is locked and cannot be edited.
+	Locked *bool `json:"locked,omitempty"`
+	// A mapping of a layer's property to component property name of component
+	// properties attached to this node.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	// An array of nodes that are direct children of this node.
+	Children  Children  `json:"children"`
+	BlendMode BlendMode `json:"blendMode"`
+	// Opacity of the node.
+	Opacity              *float64   `json:"opacity,omitempty"`
+	AbsoluteBoundingBox  Rectangle  `json:"absoluteBoundingBox"`
+	AbsoluteRenderBounds *Rectangle `json:"absoluteRenderBounds,omitempty"`
+	RelativeTransform    Transform  `json:"relativeTransform,omitempty"`
+	Size                 *Vector    `json:"size,omitempty"`
+	// An array of fill paints applied to the node.
+	Fills []Paint `json:"fills"`
+	// A mapping of a StyleType to style ID of styles present on this node.
+	Styles map[string]string `json:"styles,omitempty"`
+	// An array of stroke paints applied to the node.
+	Strokes []Paint `json:"strokes,omitempty"`
+	// The weight of strokes on the node.
+	StrokeWeight *float64 `json:"strokeWeight,omitempty"`
+	// Position of stroke relative to vector outline.
+	StrokeAlign StrokeAlign `json:"strokeAlign,omitempty"`
+	// Radius of each corner if a single radius is set for all corners.
+	CornerRadius *float64 `json:"cornerRadius,omitempty"`
+	// A value that lets you control how "smooth" the corners are.
+	CornerSmoothing *float64 `json:"cornerSmoothing,omitempty"`
+	// Array of length 4 of the radius of each corner of the frame, starting in
+	// the top left and proceeding clockwise.
+	RectangleCornerRadii []float64 `json:"rectangleCornerRadii,omitempty"`
+	// Whether or not this node clip content outside of its bounds.
+	ClipsContent bool `json:"clipsContent"`
+	// Whether this layer uses auto-layout to position its children.
+	LayoutMode LayoutMode `json:"layoutMode,omitempty"`
+	// The distance between children of the frame.
+	ItemSpacing *float64 `json:"itemSpacing,omitempty"`
+	// The padding between the left border of the frame and its children.
+	PaddingLeft *float64 `json:"paddingLeft,omitempty"`
+	// The padding between the right border of the frame and its children.
+	PaddingRight *float64 `json:"paddingRight,omitempty"`
+	// The padding between the top border of the frame and its children.
+	PaddingTop *float64 `json:"paddingTop,omitempty"`
+	// The padding between the bottom border of the frame and its children.
+	PaddingBottom *float64 `json:"paddingBottom,omitempty"`
+}
+
+// GetFileNodesResponse: Response from the GET /v1/files/{file_key}/nodes
+// endpoint.
+type GetFileNodesResponse struct {
+	// The name of the file as it appears in the editor.
+	Name string `json:"name"`
+	// The UTC ISO 8601 time at which the file was last modified.
+	LastModified string `json:"lastModified"`
+	// A URL to a thumbnail image of the file.
+	ThumbnailURL string `json:"thumbnailUrl"`
+	// The version number of the file.
+	Version string `json:"version"`
+	// A mapping from node IDs to node metadata.
+	Nodes map[string]GetFileNodesResponseNode `json:"nodes"`
+}
+
+// GetFileNodesResponseNode is generated from the API specification.
+type GetFileNodesResponseNode struct {
+	Document AnyNode `json:"document"`
+	// A mapping from component IDs to component metadata.
+	Components map[string]Component `json:"components"`
+	// A mapping from style IDs to style metadata.
+	Styles map[string]Style `json:"styles"`
+	// The version of the file schema that this file uses.
+	SchemaVersion float64 `json:"schemaVersion"`
+}
+
+// GetFileResponse: Response from the GET /v1/files/{file_key} endpoint.
+type GetFileResponse struct {
+	// The name of the file as it appears in the editor.
+	Name string `json:"name"`
+	Role Role   `json:"role,omitempty"`
+	// The UTC ISO 8601 time at which the file was last modified.
+	LastModified string `json:"lastModified"`
+	// The type of editor associated with this file.
+	EditorType EditorType `json:"editorType,omitempty"`
+	// A URL to a thumbnail image of the file.
+	ThumbnailURL string `json:"thumbnailUrl"`
+	// The version number of the file.
+	Version  string       `json:"version"`
+	Document DocumentNode `json:"document"`
+	// A mapping from component IDs to component metadata.
+	Components map[string]Component `json:"components"`
+	// A mapping from style IDs to style metadata.
+	Styles map[string]Style `json:"styles"`
+	// The version of the file schema that this file uses.
+	SchemaVersion float64 `json:"schemaVersion"`
+}
+
+// GetImagesFormat is generated from the API specification.
+type GetImagesFormat string
+
+const (
+	GetImagesFormatJPG GetImagesFormat = "jpg"
+	GetImagesFormatPNG GetImagesFormat = "png"
+	GetImagesFormatSVG GetImagesFormat = "svg"
+	GetImagesFormatPDF GetImagesFormat = "pdf"
+)
+
+// GetImagesResponse: Response from the GET /v1/images/{file_key} endpoint.
+type GetImagesResponse struct {
+	// Set if the render failed.
+	Err string `json:"err,omitempty"`
+	// A map from node IDs to URLs of the rendered images.
+	Images map[string]string `json:"images"`
+}
+
+// GetLocalVariablesResponse: Response from the GET
+// /v1/files/{file_key}/variables/local endpoint.
+type GetLocalVariablesResponse struct {
+	// The response status code.
+	Status float64 `json:"status"`
+	// For successful requests, this value is always false.
+	Error bool                          `json:"error"`
+	Meta  GetLocalVariablesResponseMeta `json:"meta"`
+}
+
+// GetLocalVariablesResponseMeta is generated from the API specification.
+type GetLocalVariablesResponseMeta struct {
+	// A map of variable IDs to variables.
+	Variables map[string]LocalVariable `json:"variables"`
+	// A map of variable collection IDs to variable collections.
+	VariableCollections map[string]LocalVariableCollection `json:"variableCollections"`
+}
+
+// GroupNode: A logical grouping of nodes.
+type GroupNode struct {
+	// A string uniquely identifying this node within the document.
+	ID string `json:"id"`
+	// The name given to the node by the user in the tool.
+	Name string `json:"name"`
+	// The type of this node, represented by the string literal "GROUP".
+	Type NodeType `json:"type"`
+	// Whether or not the node is visible on the canvas.
+	Visible *bool `json:"visible,omitempty"`
+	// If true, layer is locked and cannot be edited.
+	Locked *bool `json:"locked,omitempty"`
+	// A mapping of a layer's property to component property name of component
+	// properties attached to this node.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	// An array of nodes that are direct children of this node.
+	Children  Children  `json:"children"`
+	BlendMode BlendMode `json:"blendMode"`
+	// Opacity of the node.
+	Opacity              *float64   `json:"opacity,omitempty"`
+	AbsoluteBoundingBox  Rectangle  `json:"absoluteBoundingBox"`
+	AbsoluteRenderBounds *Rectangle `json:"absoluteRenderBounds,omitempty"`
+	RelativeTransform    Transform  `json:"relativeTransform,omitempty"`
+	Size                 *Vector    `json:"size,omitempty"`
+	// An array of fill paints applied to the node.
+	Fills []Paint `json:"fills"`
+	// A mapping of a StyleType to style ID of styles present on this node.
+	Styles map[string]string `json:"styles,omitempty"`
+}
+
+// InstanceNode: An instance of a component.
+type InstanceNode struct {
+	// A string uniquely identifying this node within the document.
+	ID string `json:"id"`
+	// The name given to the node by the user in the tool.
+	Name string `json:"name"`
+	// The type of this node, represented by the string literal "INSTANCE".
+	Type NodeType `json:"type"`
+	// Whether or not the node is visible on the canvas.
+	Visible *bool `json:"visible,omitempty"`
+	// If true, layer is locked and cannot be edited.
+	Locked *bool `json:"locked,omitempty"`
+	// A mapping of a layer's property to component property name of component
+	// properties attached to this node.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	// An array of nodes that are direct children of this node.
+	Children  Children  `json:"children"`
+	BlendMode BlendMode `json:"blendMode"`
+	// Opacity of the node.
+	Opacity              *float64   `json:"opacity,omitempty"`
+	AbsoluteBoundingBox  Rectangle  `json:"absoluteBoundingBox"`
+	AbsoluteRenderBounds *Rectangle `json:"absoluteRenderBounds,omitempty"`
+	RelativeTransform    Transform  `json:"relativeTransform,omitempty"`
+	Size                 *Vector    `json:"size,omitempty"`
+	// An array of fill paints applied to the node.
+	Fills []Paint `json:"fills"`
+	// A mapping of a StyleType to style ID of styles present on this node.
+	Styles map[string]string `json:"styles,omitempty"`
+	// An array of stroke paints applied to the node.
+	Strokes []Paint `json:"strokes,omitempty"`
+	// The weight of strokes on the node.
+	StrokeWeight *float64 `json:"strokeWeight,omitempty"`
+	// Position of stroke relative to vector outline.
+	StrokeAlign StrokeAlign `json:"strokeAlign,omitempty"`
+	// Radius of each corner if a single radius is set for all corners.
+	CornerRadius *float64 `json:"cornerRadius,omitempty"`
+	// A value that lets you control how "smooth" the corners are.
+	CornerSmoothing *float64 `json:"cornerSmoothing,omitempty"`
+	// Array of length 4 of the radius of each corner of the frame, starting in
+	// the top left and proceeding clockwise.
+	RectangleCornerRadii []float64 `json:"rectangleCornerRadii,omitempty"`
+	// Whether or not this node clip content outside of its bounds.
+	ClipsContent bool `json:"clipsContent"`
+	// Whether this layer uses auto-layout to position its children.
+	LayoutMode LayoutMode `json:"layoutMode,omitempty"`
+	// The distance between children of the frame.
+	ItemSpacing *float64 `json:"itemSpacing,omitempty"`
+	// The padding between the left border of the frame and its children.
+	PaddingLeft *float64 `json:"paddingLeft,omitempty"`
+	// The padding between the right border of the frame and its children.
+	PaddingRight *float64 `json:"paddingRight,omitempty"`
+	// The padding between the top border of the frame and its children.
+	PaddingTop *float64 `json:"paddingTop,omitempty"`
+	// The padding between the bottom border of the frame and its children.
+	PaddingBottom *float64 `json:"paddingBottom,omitempty"`
+	// ID of component that this instance came from.
+	ComponentID string `json:"componentId"`
+	// If true, this node has been marked as exposed to its containing component
+	// or component set.
+	IsExposedInstance *bool `json:"isExposedInstance,omitempty"`
+}
+
+// LayoutMode: Whether this layer uses auto-layout to position its children.
+type LayoutMode string
+
+const (
+	LayoutModeNONE       LayoutMode = "NONE"
+	LayoutModeHORIZONTAL LayoutMode = "HORIZONTAL"
+	LayoutModeVERTICAL   LayoutMode = "VERTICAL"
+	LayoutModeGRID       LayoutMode = "GRID"
+)
+
+// LocalVariable: A Variable is a single design token that defines values for
+// each of the modes in its VariableCollection.
+type LocalVariable struct {
+	// The unique identifier of this variable.
+	ID string `json:"id"`
+	// The name of this variable.
+	Name string `json:"name"`
+	// The key of this variable.
+	Key string `json:"key"`
+	// The id of the variable collection that contains this variable.
+	VariableCollectionID string                   `json:"variableCollectionId"`
+	ResolvedType         VariableResolvedDataType `json:"resolvedType"`
+	// The values for each mode of this variable.
+	ValuesByMode map[string]json.RawMessage `json:"valuesByMode"`
+	// Whether this variable is remote.
+	Remote bool `json:"remote"`
+	// The description of this variable.
+	Description string `json:"description"`
+	// Whether this variable is hidden when publishing the current file as a
+	// library.
+	HiddenFromPublishing bool `json:"hiddenFromPublishing"`
+	// An array of scopes in the UI where this variable is shown.
+	Scopes []string `json:"scopes"`
+	// Indicates that the variable was deleted in the editor, but the document
+	// may still contain references to the variable.
+	DeletedButReferenced *bool `json:"deletedButReferenced,omitempty"`
+}
+
+// LocalVariableCollection: A grouping of related Variable objects each with
+// the same modes.
+type LocalVariableCollection struct {
+	// The unique identifier of this variable collection.
+	ID string `json:"id"`
+	// The name of this variable collection.
+	Name string `json:"name"`
+	// The key of this variable collection.
+	Key string `json:"key"`
+	// The modes of this variable collection.
+	Modes []LocalVariableCollectionMode `json:"modes"`
+	// The id of the default mode.
+	DefaultModeID string `json:"defaultModeId"`
+	// Whether this variable collection is remote.
+	Remote bool `json:"remote"`
+	// Whether this variable collection is hidden when publishing the current
+	// file as a library.
+	HiddenFromPublishing bool `json:"hiddenFromPublishing"`
+	// The ids of the variables in the collection.
+	VariableIDs []string `json:"variableIds"`
+}
+
+// LocalVariableCollectionMode is generated from the API specification.
+type LocalVariableCollectionMode struct {
+	// The unique identifier of this mode.
+	ModeID string `json:"modeId"`
+	// The name of this mode.
+	Name string `json:"name"`
+}
+
+// Paint: A paint applied to the fills or strokes of a node.
+type Paint struct {
+	Type PaintType `json:"type"`
+	// Is the paint enabled?
+	Visible *bool `json:"visible,omitempty"`
+	// Overall opacity of paint.
+	Opacity   *float64  `json:"opacity,omitempty"`
+	BlendMode BlendMode `json:"blendMode,omitempty"`
+	Color     *RGBA     `json:"color,omitempty"`
+	// A reference to an image embedded in this node.
+	ImageRef string `json:"imageRef,omitempty"`
+}
+
+// PaintType: The type of a paint.
+type PaintType string
+
+const (
+	PaintTypeSOLID            PaintType = "SOLID"
+	PaintTypeGRADIENT_LINEAR  PaintType = "GRADIENT_LINEAR"
+	PaintTypeGRADIENT_RADIAL  PaintType = "GRADIENT_RADIAL"
+	PaintTypeGRADIENT_ANGULAR PaintType = "GRADIENT_ANGULAR"
+	PaintTypeGRADIENT_DIAMOND PaintType = "GRADIENT_DIAMOND"
+	PaintTypeIMAGE            PaintType = "IMAGE"
+	PaintTypeEMOJI            PaintType = "EMOJI"
+	PaintTypeVIDEO            PaintType = "VIDEO"
+)
+
+// RGBA: An RGBA color.
+type RGBA struct {
+	// Red channel value, between 0 and 1.
+	R float64 `json:"r"`
+	// Green channel value, between 0 and 1.
+	G float64 `json:"g"`
+	// Blue channel value, between 0 and 1.
+	B float64 `json:"b"`
+	// Alpha channel value, between 0 and 1.
+	A float64 `json:"a"`
+}
+
+// Rectangle: A rectangle that expresses a bounding box in absolute
+// coordinates.
+type Rectangle struct {
+	// X coordinate of top left corner of the rectangle.
+	X float64 `json:"x"`
+	// Y coordinate of top left corner of the rectangle.
+	Y float64 `json:"y"`
+	// Width of the rectangle.
+	Width float64 `json:"width"`
+	// Height of the rectangle.
+	Height float64 `json:"height"`
+}
+
+// RectangleNode: A rectangle.
+type RectangleNode struct {
+	// A string uniquely identifying this node within the document.
+	ID string `json:"id"`
+	// The name given to the node by the user in the tool.
+	Name string `json:"name"`
+	// The type of this node, represented by the string literal "RECTANGLE".
+	Type NodeType `json:"type"`
+	// Whether or not the node is visible on the canvas.
+	Visible *bool `json:"visible,omitempty"`
+	// If true, layer is locked and cannot be edited.
+	Locked *bool `json:"locked,omitempty"`
+	// A mapping of a layer's property to component property name of component
+	// properties attached to this node.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	BlendMode                   BlendMode         `json:"blendMode"`
+	// Opacity of the node.
+	Opacity              *float64   `json:"opacity,omitempty"`
+	AbsoluteBoundingBox  Rectangle  `json:"absoluteBoundingBox"`
+	AbsoluteRenderBounds *Rectangle `json:"absoluteRenderBounds,omitempty"`
+	RelativeTransform    Transform  `json:"relativeTransform,omitempty"`
+	Size                 *Vector    `json:"size,omitempty"`
+	// An array of fill paints applied to the node.
+	Fills []Paint `json:"fills"`
+	// A mapping of a StyleType to style ID of styles present on this node.
+	Styles map[string]string `json:"styles,omitempty"`
+	// An array of stroke paints applied to the node.
+	Strokes []Paint `json:"strokes,omitempty"`
+	// The weight of strokes on the node.
+	StrokeWeight *float64 `json:"strokeWeight,omitempty"`
+	// Position of stroke relative to vector outline.
+	StrokeAlign StrokeAlign `json:"strokeAlign,omitempty"`
+	// Radius of each corner if a single radius is set for all corners.
+	CornerRadius *float64 `json:"cornerRadius,omitempty"`
+	// A value that lets you control how "smooth" the corners are.
+	CornerSmoothing *float64 `json:"cornerSmoothing,omitempty"`
+	// Array of length 4 of the radius of each corner of the frame, starting in
+	// the top left and proceeding clockwise.
+	RectangleCornerRadii []float64 `json:"rectangleCornerRadii,omitempty"`
+}
+
+// Role: The role of the user making the API request in relation to the file.
+type Role string
+
+const (
+	RoleOWNER  Role = "owner"
+	RoleEDITOR Role = "editor"
+	RoleVIEWER Role = "viewer"
+)
+
+// StrokeAlign: Position of stroke relative to vector outline.
+type StrokeAlign string
+
+const (
+	StrokeAlignINSIDE  StrokeAlign = "INSIDE"
+	StrokeAlignOUTSIDE StrokeAlign = "OUTSIDE"
+	StrokeAlignCENTER  StrokeAlign = "CENTER"
+)
+
+// Style: A set of properties that can be applied to nodes and published.
+type Style struct {
+	// The key of the style.
+	Key string `json:"key"`
+	// Name of the style.
+	Name string `json:"name"`
+	// Description of the style.
+	Description string `json:"description"`
+	// Whether this style is a remote style that doesn't live in this file.
+	Remote    bool      `json:"remote"`
+	StyleType StyleType `json:"styleType"`
+}
+
+// StyleType: The type of style.
+type StyleType string
+
+const (
+	StyleTypeFILL   StyleType = "FILL"
+	StyleTypeTEXT   StyleType = "TEXT"
+	StyleTypeEFFECT StyleType = "EFFECT"
+	StyleTypeGRID   StyleType = "GRID"
+)
+
+// TextAlignHorizontal: Horizontal text alignment as string enum.
+type TextAlignHorizontal string
+
+const (
+	TextAlignHorizontalLEFT      TextAlignHorizontal = "LEFT"
+	TextAlignHorizontalRIGHT     TextAlignHorizontal = "RIGHT"
+	TextAlignHorizontalCENTER    TextAlignHorizontal = "CENTER"
+	TextAlignHorizontalJUSTIFIED TextAlignHorizontal = "JUSTIFIED"
+)
+
+// TextNode: A text box.
+type TextNode struct {
+	// A string uniquely identifying this node within the document.
+	ID string `json:"id"`
+	// The name given to the node by the user in the tool.
+	Name string `json:"name"`
+	// The type of this node, represented by the string literal "TEXT".
+	Type NodeType `json:"type"`
+	// Whether or not the node is visible on the canvas.
+	Visible *bool `json:"visible,omitempty"`
+	// If true, layer is locked and cannot be edited.
+	Locked *bool `json:"locked,omitempty"`
+	// A mapping of a layer's property to component property name of component
+	// properties attached to this node.
+	ComponentPropertyReferences map[string]string `json:"componentPropertyReferences,omitempty"`
+	BlendMode                   BlendMode         `json:"blendMode"`
+	// Opacity of the node.
+	Opacity              *float64   `json:"opacity,omitempty"`
+	AbsoluteBoundingBox  Rectangle  `json:"absoluteBoundingBox"`
+	AbsoluteRenderBounds *Rectangle `json:"absoluteRenderBounds,omitempty"`
+	RelativeTransform    Transform  `json:"relativeTransform,omitempty"`
+	Size                 *Vector    `json:"size,omitempty"`
+	// An array of fill paints applied to the node.
+	Fills []Paint `json:"fills"`
+	// A mapping of a StyleType to style ID of styles present on this node.
+	Styles map[string]string `json:"styles,omitempty"`
+	// The raw characters in the text node.
+	Characters string    `json:"characters"`
+	Style      TypeStyle `json:"style"`
+	// The array corresponds to characters in the text box, where each element
+	// references the styleOverrideTable.
+	CharacterStyleOverrides []float64 `json:"characterStyleOverrides"`
+	// Map from ID to TypeStyle for looking up style overrides.
+	StyleOverrideTable map[string]TypeStyle `json:"styleOverrideTable,omitempty"`
+}
+
+// Transform: A transformation matrix is standard way in computer graphics to
+// represent translation and rotation.
+type Transform [][]float64
+
+// TypeStyle: Metadata for character formatting.
+type TypeStyle struct {
+	// Font family of text (standard name).
+	FontFamily string `json:"fontFamily,omitempty"`
+	// PostScript font name.
+	FontPostScriptName string `json:"fontPostScriptName,omitempty"`
+	// Whether or not text is italicized.
+	Italic *bool `json:"italic,omitempty"`
+	// Numeric font weight.
+	FontWeight *float64 `json:"fontWeight,omitempty"`
+	// Font size in px.
+	FontSize *float64 `json:"fontSize,omitempty"`
+	// Horizontal text alignment as string enum.
+	TextAlignHorizontal TextAlignHorizontal `json:"textAlignHorizontal,omitempty"`
+	// Space between characters in px.
+	LetterSpacing *float64 `json:"letterSpacing,omitempty"`
+	// Line height in px.
+	LineHeightPx *float64 `json:"lineHeightPx,omitempty"`
+}
+
+// VariableAlias: Contains a variable alias.
+type VariableAlias struct {
+	Type VariableAliasType `json:"type"`
+	// The id of the variable that the current variable is aliased to.
+	ID string `json:"id"`
+}
+
+// VariableAliasType is generated from the API specification.
+type VariableAliasType string
+
+const (
+	VariableAliasTypeVARIABLE_ALIAS VariableAliasType = "VARIABLE_ALIAS"
+)
+
+// VariableResolvedDataType: Defines the types of data a VariableData object
+// can eventually equal.
+type VariableResolvedDataType string
+
+const (
+	VariableResolvedDataTypeBOOLEAN VariableResolvedDataType = "BOOLEAN"
+	VariableResolvedDataTypeFLOAT   VariableResolvedDataType = "FLOAT"
+	VariableResolvedDataTypeSTRING  VariableResolvedDataType = "STRING"
+	VariableResolvedDataTypeCOLOR   VariableResolvedDataType = "COLOR"
+)
+
+// Vector: A 2d vector.
+type Vector struct {
+	// X coordinate of the vector.
+	X float64 `json:"x"`
+	// Y coordinate of the vector.
+	Y float64 `json:"y"`
+}
+
+// GetID returns the ID of the node.
+func (n *CanvasNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *CanvasNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *CanvasNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *CanvasNode) GetVisible() *bool { return n.Visible }
+
+// GetChildren returns the children of the node.
+func (n *CanvasNode) GetChildren() Children { return n.Children }
+
+// GetID returns the ID of the node.
+func (n *ComponentNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *ComponentNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *ComponentNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *ComponentNode) GetVisible() *bool { return n.Visible }
+
+// GetChildren returns the children of the node.
+func (n *ComponentNode) GetChildren() Children { return n.Children }
+
+// GetID returns the ID of the node.
+func (n *DocumentNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *DocumentNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *DocumentNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *DocumentNode) GetVisible() *bool { return n.Visible }
+
+// GetChildren returns the children of the node.
+func (n *DocumentNode) GetChildren() Children {
+	children := make(Children, len(n.Children))
+	for i := range n.Children {
+		children[i] = &n.Children[i]
+	}
+	return children
+}
+
+// GetID returns the ID of the node.
+func (n *FrameNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *FrameNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *FrameNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *FrameNode) GetVisible() *bool { return n.Visible }
+
+// GetChildren returns the children of the node.
+func (n *FrameNode) GetChildren() Children { return n.Children }
+
+// GetID returns the ID of the node.
+func (n *GroupNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *GroupNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *GroupNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *GroupNode) GetVisible() *bool { return n.Visible }
+
+// GetChildren returns the children of the node.
+func (n *GroupNode) GetChildren() Children { return n.Children }
+
+// GetID returns the ID of the node.
+func (n *InstanceNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *InstanceNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *InstanceNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *InstanceNode) GetVisible() *bool { return n.Visible }
+
+// GetChildren returns the children of the node.
+func (n *InstanceNode) GetChildren() Children { return n.Children }
+
+// GetID returns the ID of the node.
+func (n *RectangleNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *RectangleNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *RectangleNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *RectangleNode) GetVisible() *bool { return n.Visible }
+
+// GetID returns the ID of the node.
+func (n *TextNode) GetID() string { return n.ID }
+
+// GetName returns the name of the node.
+func (n *TextNode) GetName() string { return n.Name }
+
+// GetType returns the type of the node.
+func (n *TextNode) GetType() NodeType { return n.Type }
+
+// GetVisible reports whether the node is visible, or nil if unset.
+func (n *TextNode) GetVisible() *bool { return n.Visible }
+
+// GetFileParams holds the parameters of the getFile operation (GET
+// /v1/files/{file_key}).
+type GetFileParams struct {
+	// File to export JSON from.
+	FileKey string
+	// A specific version ID to get.
+	Version string
+	// Comma separated list of nodes that you care about in the document.
+	IDs string
+	// Positive integer representing how deep into the document tree to traverse.
+	Depth *int
+	// Set to "paths" to export vector data.
+	Geometry string
+	// A comma separated list of plugin IDs and/or the string "shared".
+	PluginData string
+	// Returns branch metadata for the requested file.
+	BranchData *bool
+}
+
+// Path returns the request path of the getFile operation.
+func (p *GetFileParams) Path() string {
+	return "/v1/files/" + url.PathEscape(p.FileKey)
+}
+
+// Query returns the query parameters of the getFile operation.
+func (p *GetFileParams) Query() url.Values {
+	v := make(url.Values)
+	if p.Version != "" {
+		v.Set("version", p.Version)
+	}
+	if p.IDs != "" {
+		v.Set("ids", p.IDs)
+	}
+	if p.Depth != nil {
+		v.Set("depth", strconv.Itoa(*p.Depth))
+	}
+	if p.Geometry != "" {
+		v.Set("geometry", p.Geometry)
+	}
+	if p.PluginData != "" {
+		v.Set("plugin_data", p.PluginData)
+	}
+	if p.BranchData != nil {
+		v.Set("branch_data", strconv.FormatBool(*p.BranchData))
+	}
+	return v
+}
+
+// GetFileNodesParams holds the parameters of the getFileNodes operation (GET
+// /v1/files/{file_key}/nodes).
+type GetFileNodesParams struct {
+	// File to export JSON from.
+	FileKey string
+	// A comma separated list of node IDs to retrieve and convert.
+	IDs string
+	// A specific version ID to get.
+	Version string
+	// Positive integer representing how deep into the node tree to traverse.
+	Depth *int
+	// Set to "paths" to export vector data.
+	Geometry string
+	// A comma separated list of plugin IDs and/or the string "shared".
+	PluginData string
+}
+
+// Path returns the request path of the getFileNodes operation.
+func (p *GetFileNodesParams) Path() string {
+	return "/v1/files/" + url.PathEscape(p.FileKey) + "/nodes"
+}
+
+// Query returns the query parameters of the getFileNodes operation.
+func (p *GetFileNodesParams) Query() url.Values {
+	v := make(url.Values)
+	if p.IDs != "" {
+		v.Set("ids", p.IDs)
+	}
+	if p.Version != "" {
+		v.Set("version", p.Version)
+	}
+	if p.Depth != nil {
+		v.Set("depth", strconv.Itoa(*p.Depth))
+	}
+	if p.Geometry != "" {
+		v.Set("geometry", p.Geometry)
+	}
+	if p.PluginData != "" {
+		v.Set("plugin_data", p.PluginData)
+	}
+	return v
+}
+
+// GetLocalVariablesParams holds the parameters of the getLocalVariables
+// operation (GET /v1/files/{file_key}/variables/local).
+type GetLocalVariablesParams struct {
+	// File to export JSON from.
+	FileKey string
+}
+
+// Path returns the request path of the getLocalVariables operation.
+func (p *GetLocalVariablesParams) Path() string {
+	return "/v1/files/" + url.PathEscape(p.FileKey) + "/variables/local"
+}
+
+// Query returns the query parameters of the getLocalVariables operation.
+func (p *GetLocalVariablesParams) Query() url.Values {
+	v := make(url.Values)
+	return v
+}
+
+// GetImagesParams holds the parameters of the getImages operation (GET
+// /v1/images/{file_key}).
+type GetImagesParams struct {
+	// File to export JSON from.
+	FileKey string
+	// A comma separated list of node IDs to render.
+	IDs string
+	// A specific version ID to get.
+	Version string
+	// A number between 0.01 and 4, the image scaling factor.
+	Scale *float64
+	// A string enum for the image output format.
+	Format GetImagesFormat
+	// Whether to include id attributes for all SVG elements.
+	SVGIncludeID *bool
+	// Use the full dimensions of the node regardless of whether or not it is
+	// cropped.
+	UseAbsoluteBounds *bool
+}
+
+// Path returns the request path of the getImages operation.
+func (p *GetImagesParams) Path() string {
+	return "/v1/images/" + url.PathEscape(p.FileKey)
+}
+
+// Query returns the query parameters of the getImages operation.
+func (p *GetImagesParams) Query() url.Values {
+	v := make(url.Values)
+	if p.IDs != "" {
+		v.Set("ids", p.IDs)
+	}
+	if p.Version != "" {
+		v.Set("version", p.Version)
+	}
+	if p.Scale != nil {
+		v.Set("scale", strconv.FormatFloat(*p.Scale, 'g', -1, 64))
+	}
+	if p.Format != "" {
+		v.Set("format", string(p.Format))
+	}
+	if p.SVGIncludeID != nil {
+		v.Set("svg_include_id", strconv.FormatBool(*p.SVGIncludeID))
+	}
+	if p.UseAbsoluteBounds != nil {
+		v.Set("use_absolute_bounds", strconv.FormatBool(*p.UseAbsoluteBounds))
+	}
+	return v
+}
@@ -0,0 +1,17 @@
+// Package restapi holds Go types generated from Figma's REST API
+// specification.
+//
+// The node structs, enums, request parameters and response types in
+// api_gen.go are produced by internal/cmd/apigen from the OpenAPI document
+// vendored under third_party/figma-rest-api-spec; enum methods are produced
+// by enumgen. To pick up a new version of the API, replace the vendored
+// document and run go generate.
+//
+// Nodes implement Node, and nodes with children implement Parent. Lists of
+// children decode into Children, which picks the struct for each node from
+// its type; nodes of types missing from the specification decode into
+// UnknownNode and encode back unchanged.
+package restapi
+
+//go:generate go run ../internal/cmd/apigen -spec ../third_party/figma-rest-api-spec/openapi.json
+//go:generate go run ../internal/cmd/enumgen
@@ -0,0 +1,490 @@
+// Code generated by enumgen. DO NOT EDIT.
+
+package restapi
+
+// String returns the value as a string.
+func (v NodeType) String() string { return string(v) }
+
+// IsValid reports whether v is a known NodeType.
+func (v NodeType) IsValid() bool {
+	switch v {
+	case NodeTypeCANVAS,
+		NodeTypeCOMPONENT,
+		NodeTypeDOCUMENT,
+		NodeTypeFRAME,
+		NodeTypeGROUP,
+		NodeTypeINSTANCE,
+		NodeTypeRECTANGLE,
+		NodeTypeTEXT:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v NodeType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *NodeType) UnmarshalText(text []byte) error {
+	*v = NodeType(text)
+	return nil
+}
+
+// NodeTypeValues returns the known NodeType values.
+func NodeTypeValues() []NodeType {
+	return []NodeType{
+		NodeTypeCANVAS,
+		NodeTypeCOMPONENT,
+		NodeTypeDOCUMENT,
+		NodeTypeFRAME,
+		NodeTypeGROUP,
+		NodeTypeINSTANCE,
+		NodeTypeRECTANGLE,
+		NodeTypeTEXT,
+	}
+}
+
+// String returns the value as a string.
+func (v BlendMode) String() string { return string(v) }
+
+// IsValid reports whether v is a known BlendMode.
+func (v BlendMode) IsValid() bool {
+	switch v {
+	case BlendModePASS_THROUGH,
+		BlendModeNORMAL,
+		BlendModeDARKEN,
+		BlendModeMULTIPLY,
+		BlendModeLINEAR_BURN,
+		BlendModeCOLOR_BURN,
+		BlendModeLIGHTEN,
+		BlendModeSCREEN,
+		BlendModeLINEAR_DODGE,
+		BlendModeCOLOR_DODGE,
+		BlendModeOVERLAY,
+		BlendModeSOFT_LIGHT,
+		BlendModeHARD_LIGHT,
+		BlendModeDIFFERENCE,
+		BlendModeEXCLUSION,
+		BlendModeHUE,
+		BlendModeSATURATION,
+		BlendModeCOLOR,
+		BlendModeLUMINOSITY:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v BlendMode) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *BlendMode) UnmarshalText(text []byte) error {
+	*v = BlendMode(text)
+	return nil
+}
+
+// BlendModeValues returns the known BlendMode values.
+func BlendModeValues() []BlendMode {
+	return []BlendMode{
+		BlendModePASS_THROUGH,
+		BlendModeNORMAL,
+		BlendModeDARKEN,
+		BlendModeMULTIPLY,
+		BlendModeLINEAR_BURN,
+		BlendModeCOLOR_BURN,
+		BlendModeLIGHTEN,
+		BlendModeSCREEN,
+		BlendModeLINEAR_DODGE,
+		BlendModeCOLOR_DODGE,
+		BlendModeOVERLAY,
+		BlendModeSOFT_LIGHT,
+		BlendModeHARD_LIGHT,
+		BlendModeDIFFERENCE,
+		BlendModeEXCLUSION,
+		BlendModeHUE,
+		BlendModeSATURATION,
+		BlendModeCOLOR,
+		BlendModeLUMINOSITY,
+	}
+}
+
+// String returns the value as a string.
+func (v ComponentPropertyType) String() string { return string(v) }
+
+// IsValid reports whether v is a known ComponentPropertyType.
+func (v ComponentPropertyType) IsValid() bool {
+	switch v {
+	case ComponentPropertyTypeBOOLEAN,
+		ComponentPropertyTypeINSTANCE_SWAP,
+		ComponentPropertyTypeTEXT,
+		ComponentPropertyTypeVARIANT:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v ComponentPropertyType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *ComponentPropertyType) UnmarshalText(text []byte) error {
+	*v = ComponentPropertyType(text)
+	return nil
+}
+
+// ComponentPropertyTypeValues returns the known ComponentPropertyType values.
+func ComponentPropertyTypeValues() []ComponentPropertyType {
+	return []ComponentPropertyType{
+		ComponentPropertyTypeBOOLEAN,
+		ComponentPropertyTypeINSTANCE_SWAP,
+		ComponentPropertyTypeTEXT,
+		ComponentPropertyTypeVARIANT,
+	}
+}
+
+// String returns the value as a string.
+func (v EditorType) String() string { return string(v) }
+
+// IsValid reports whether v is a known EditorType.
+func (v EditorType) IsValid() bool {
+	switch v {
+	case EditorTypeFIGMA,
+		EditorTypeFIGJAM:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v EditorType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *EditorType) UnmarshalText(text []byte) error {
+	*v = EditorType(text)
+	return nil
+}
+
+// EditorTypeValues returns the known EditorType values.
+func EditorTypeValues() []EditorType {
+	return []EditorType{
+		EditorTypeFIGMA,
+		EditorTypeFIGJAM,
+	}
+}
+
+// String returns the value as a string.
+func (v GetImagesFormat) String() string { return string(v) }
+
+// IsValid reports whether v is a known GetImagesFormat.
+func (v GetImagesFormat) IsValid() bool {
+	switch v {
+	case GetImagesFormatJPG,
+		GetImagesFormatPNG,
+		GetImagesFormatSVG,
+		GetImagesFormatPDF:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v GetImagesFormat) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *GetImagesFormat) UnmarshalText(text []byte) error {
+	*v = GetImagesFormat(text)
+	return nil
+}
+
+// GetImagesFormatValues returns the known GetImagesFormat values.
+func GetImagesFormatValues() []GetImagesFormat {
+	return []GetImagesFormat{
+		GetImagesFormatJPG,
+		GetImagesFormatPNG,
+		GetImagesFormatSVG,
+		GetImagesFormatPDF,
+	}
+}
+
+// String returns the value as a string.
+func (v LayoutMode) String() string { return string(v) }
+
+// IsValid reports whether v is a known LayoutMode.
+func (v LayoutMode) IsValid() bool {
+	switch v {
+	case LayoutModeNONE,
+		LayoutModeHORIZONTAL,
+		LayoutModeVERTICAL,
+		LayoutModeGRID:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v LayoutMode) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *LayoutMode) UnmarshalText(text []byte) error {
+	*v = LayoutMode(text)
+	return nil
+}
+
+// LayoutModeValues returns the known LayoutMode values.
+func LayoutModeValues() []LayoutMode {
+	return []LayoutMode{
+		LayoutModeNONE,
+		LayoutModeHORIZONTAL,
+		LayoutModeVERTICAL,
+		LayoutModeGRID,
+	}
+}
+
+// String returns the value as a string.
+func (v PaintType) String() string { return string(v) }
+
+// IsValid reports whether v is a known PaintType.
+func (v PaintType) IsValid() bool {
+	switch v {
+	case PaintTypeSOLID,
+		PaintTypeGRADIENT_LINEAR,
+		PaintTypeGRADIENT_RADIAL,
+		PaintTypeGRADIENT_ANGULAR,
+		PaintTypeGRADIENT_DIAMOND,
+		PaintTypeIMAGE,
+		PaintTypeEMOJI,
+		PaintTypeVIDEO:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v PaintType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *PaintType) UnmarshalText(text []byte) error {
+	*v = PaintType(text)
+	return nil
+}
+
+// PaintTypeValues returns the known PaintType values.
+func PaintTypeValues() []PaintType {
+	return []PaintType{
+		PaintTypeSOLID,
+		PaintTypeGRADIENT_LINEAR,
+		PaintTypeGRADIENT_RADIAL,
+		PaintTypeGRADIENT_ANGULAR,
+		PaintTypeGRADIENT_DIAMOND,
+		PaintTypeIMAGE,
+		PaintTypeEMOJI,
+		PaintTypeVIDEO,
+	}
+}
+
+// String returns the value as a string.
+func (v Role) String() string { return string(v) }
+
+// IsValid reports whether v is a known Role.
+func (v Role) IsValid() bool {
+	switch v {
+	case RoleOWNER,
+		RoleEDITOR,
+		RoleVIEWER:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v Role) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *Role) UnmarshalText(text []byte) error {
+	*v = Role(text)
+	return nil
+}
+
+// RoleValues returns the known Role values.
+func RoleValues() []Role {
+	return []Role{
+		RoleOWNER,
+		RoleEDITOR,
+		RoleVIEWER,
+	}
+}
+
+// String returns the value as a string.
+func (v StrokeAlign) String() string { return string(v) }
+
+// IsValid reports whether v is a known StrokeAlign.
+func (v StrokeAlign) IsValid() bool {
+	switch v {
+	case StrokeAlignINSIDE,
+		StrokeAlignOUTSIDE,
+		StrokeAlignCENTER:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v StrokeAlign) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *StrokeAlign) UnmarshalText(text []byte) error {
+	*v = StrokeAlign(text)
+	return nil
+}
+
+// StrokeAlignValues returns the known StrokeAlign values.
+func StrokeAlignValues() []StrokeAlign {
+	return []StrokeAlign{
+		StrokeAlignINSIDE,
+		StrokeAlignOUTSIDE,
+		StrokeAlignCENTER,
+	}
+}
+
+// String returns the value as a string.
+func (v StyleType) String() string { return string(v) }
+
+// IsValid reports whether v is a known StyleType.
+func (v StyleType) IsValid() bool {
+	switch v {
+	case StyleTypeFILL,
+		StyleTypeTEXT,
+		StyleTypeEFFECT,
+		StyleTypeGRID:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v StyleType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *StyleType) UnmarshalText(text []byte) error {
+	*v = StyleType(text)
+	return nil
+}
+
+// StyleTypeValues returns the known StyleType values.
+func StyleTypeValues() []StyleType {
+	return []StyleType{
+		StyleTypeFILL,
+		StyleTypeTEXT,
+		StyleTypeEFFECT,
+		StyleTypeGRID,
+	}
+}
+
+// String returns the value as a string.
+func (v TextAlignHorizontal) String() string { return string(v) }
+
+// IsValid reports whether v is a known TextAlignHorizontal.
+func (v TextAlignHorizontal) IsValid() bool {
+	switch v {
+	case TextAlignHorizontalLEFT,
+		TextAlignHorizontalRIGHT,
+		TextAlignHorizontalCENTER,
+		TextAlignHorizontalJUSTIFIED:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v TextAlignHorizontal) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *TextAlignHorizontal) UnmarshalText(text []byte) error {
+	*v = TextAlignHorizontal(text)
+	return nil
+}
+
+// TextAlignHorizontalValues returns the known TextAlignHorizontal values.
+func TextAlignHorizontalValues() []TextAlignHorizontal {
+	return []TextAlignHorizontal{
+		TextAlignHorizontalLEFT,
+		TextAlignHorizontalRIGHT,
+		TextAlignHorizontalCENTER,
+		TextAlignHorizontalJUSTIFIED,
+	}
+}
+
+// String returns the value as a string.
+func (v VariableAliasType) String() string { return string(v) }
+
+// IsValid reports whether v is a known VariableAliasType.
+func (v VariableAliasType) IsValid() bool {
+	switch v {
+	case VariableAliasTypeVARIABLE_ALIAS:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v VariableAliasType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *VariableAliasType) UnmarshalText(text []byte) error {
+	*v = VariableAliasType(text)
+	return nil
+}
+
+// VariableAliasTypeValues returns the known VariableAliasType values.
+func VariableAliasTypeValues() []VariableAliasType {
+	return []VariableAliasType{
+		VariableAliasTypeVARIABLE_ALIAS,
+	}
+}
+
+// String returns the value as a string.
+func (v VariableResolvedDataType) String() string { return string(v) }
+
+// IsValid reports whether v is a known VariableResolvedDataType.
+func (v VariableResolvedDataType) IsValid() bool {
+	switch v {
+	case VariableResolvedDataTypeBOOLEAN,
+		VariableResolvedDataTypeFLOAT,
+		VariableResolvedDataTypeSTRING,
+		VariableResolvedDataTypeCOLOR:
+		return true
+	}
+	return false
+}
+
+// MarshalText implements encoding.TextMarshaler. Unknown values are kept.
+func (v VariableResolvedDataType) MarshalText() ([]byte, error) { return []byte(v), nil }
+
+// UnmarshalText implements encoding.TextUnmarshaler. Unknown values are kept;
+// see figmatypes.Strict to reject them.
+func (v *VariableResolvedDataType) UnmarshalText(text []byte) error {
+	*v = VariableResolvedDataType(text)
+	return nil
+}
+
+// VariableResolvedDataTypeValues returns the known VariableResolvedDataType values.
+func VariableResolvedDataTypeValues() []VariableResolvedDataType {
+	return []VariableResolvedDataType{
+		VariableResolvedDataTypeBOOLEAN,
+		VariableResolvedDataTypeFLOAT,
+		VariableResolvedDataTypeSTRING,
+		VariableResolvedDataTypeCOLOR,
+	}
+}
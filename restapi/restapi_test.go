@@ -0,0 +1,89 @@
+package restapi
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+)
+
+func TestDecodeFile(t *testing.T) {
+	data, err := os.ReadFile("../testdata/roundtrip/design-system.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var f GetFileResponse
+	if err := json.Unmarshal(data, &f); err != nil {
+		t.Fatal(err)
+	}
+	if f.Document.Type != NodeTypeDOCUMENT || len(f.Document.Children) == 0 {
+		t.Fatalf("document = %+v", f.Document)
+	}
+	var count int
+	var walk func(n Node)
+	walk = func(n Node) {
+		count++
+		if n.GetID() == "" || n.GetType() == "" {
+			t.Errorf("node %T missing id or type", n)
+		}
+		if New(n.GetType()) == nil {
+			if _, ok := n.(*UnknownNode); !ok {
+				t.Errorf("node of unknown type %s decoded as %T", n.GetType(), n)
+			}
+		}
+		if p, ok := n.(Parent); ok {
+			for _, c := range p.GetChildren() {
+				walk(c)
+			}
+		}
+	}
+	walk(&f.Document)
+	if count < 3 {
+		t.Errorf("walked %d nodes", count)
+	}
+}
+
+func TestChildren(t *testing.T) {
+	var c Children
+	err := json.Unmarshal([]byte(`[
+		{"id": "1:1", "name": "Card", "type": "FRAME", "clipsContent": true, "children": [
+			{"id": "1:2", "name": "Title", "type": "TEXT", "characters": "Hi"}
+		]},
+		{"id": "1:3", "name": "Star", "type": "STAR", "pointCount": 5}
+	]`), &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	frame, ok := c[0].(*FrameNode)
+	if !ok || !frame.ClipsContent {
+		t.Fatalf("c[0] = %#v", c[0])
+	}
+	if text, ok := frame.Children[0].(*TextNode); !ok || text.Characters != "Hi" {
+		t.Errorf("frame child = %#v", frame.Children[0])
+	}
+	star, ok := c[1].(*UnknownNode)
+	if !ok || star.GetName() != "Star" {
+		t.Fatalf("c[1] = %#v", c[1])
+	}
+	out, err := json.Marshal(star)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := `{"id":"1:3","name":"Star","type":"STAR","pointCount":5}`; string(out) != want {
+		t.Errorf("unknown node encoded as %s, want %s", out, want)
+	}
+}
+
+func TestParams(t *testing.T) {
+	depth, scale := 2, 0.5
+	p := &GetFileParams{FileKey: "a/b", IDs: "1:2,1:3", Depth: &depth}
+	if got, want := p.Path(), "/v1/files/a%2Fb"; got != want {
+		t.Errorf("Path = %q, want %q", got, want)
+	}
+	if got, want := p.Query().Encode(), "depth=2&ids=1%3A2%2C1%3A3"; got != want {
+		t.Errorf("Query = %q, want %q", got, want)
+	}
+	img := &GetImagesParams{FileKey: "k", IDs: "1:2", Scale: &scale, Format: GetImagesFormatSVG}
+	if got, want := img.Query().Encode(), "format=svg&ids=1%3A2&scale=0.5"; got != want {
+		t.Errorf("Query = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,272 @@
+// Package richtext renders the styled runs of text nodes as HTML, Markdown and
+// ANSI-formatted terminal output, preserving bold, italic, underline,
+// strikethrough and link ranges.
+//
+//	fmt.Println(richtext.Markdown(text.Runs()))
+package richtext
+
+import (
+	"fmt"
+	"html"
+	"slices"
+	"strings"
+
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// format is the subset of a style the renderers express.
+type format struct {
+	bold, italic, underline, strike bool
+	// The link target: a URL, or "#" followed by a node ID for links within the file.
+	link string
+	// The color of the first visible solid fill, if any.
+	color *figmatypes.Color
+}
+
+func formatOf(s figmatypes.TypeStyle) format {
+	f := format{
+		bold:      s.IsBold(),
+		italic:    s.IsItalic(),
+		underline: s.TextDecoration == figmatypes.TextDecorationUNDERLINE,
+		strike:    s.TextDecoration == figmatypes.TextDecorationSTRIKETHROUGH,
+	}
+	if l := s.Hyperlink; l != nil {
+		switch l.Type {
+		case figmatypes.HyperlinkTypeURL:
+			f.link = l.URL
+		case figmatypes.HyperlinkTypeNODE:
+			f.link = "#" + l.NodeID
+		}
+	}
+	for _, p := range s.Fills {
+		if p.Type == figmatypes.PaintTypeSOLID && p.Color != nil && p.IsVisible() {
+			f.color = p.Color
+			break
+		}
+	}
+	return f
+}
+
+func (f format) equal(g format) bool {
+	if (f.color == nil) != (g.color == nil) || f.color != nil && *f.color != *g.color {
+		return false
+	}
+	f.color, g.color = nil, nil
+	return f == g
+}
+
+type span struct {
+	text string
+	format
+}
+
+// spans converts runs to spans, joining neighbors that render the same.
+// ignoreColor drops colors for renderers that do not express them.
+func spans(runs []nodes.TextRun, ignoreColor bool) []span {
+	var out []span
+	for _, r := range runs {
+		f := formatOf(r.Style)
+		if ignoreColor {
+			f.color = nil
+		}
+		if n := len(out); n > 0 && out[n-1].format.equal(f) {
+			out[n-1].text += r.Text
+			continue
+		}
+		out = append(out, span{r.Text, f})
+	}
+	return out
+}
+
+// HTML renders runs as HTML. Line breaks become <br> elements.
+func HTML(runs []nodes.TextRun) string {
+	var b strings.Builder
+	for _, s := range spans(runs, true) {
+		var open, close []string
+		tag := func(o, c string) {
+			open = append(open, o)
+			close = append([]string{c}, close...)
+		}
+		if s.link != "" {
+			tag(`<a href="`+html.EscapeString(s.link)+`">`, "</a>")
+		}
+		if s.bold {
+			tag("<strong>", "</strong>")
+		}
+		if s.italic {
+			tag("<em>", "</em>")
+		}
+		if s.underline {
+			tag("<u>", "</u>")
+		}
+		if s.strike {
+			tag("<s>", "</s>")
+		}
+		text := strings.ReplaceAll(html.EscapeString(s.text), "\n", "<br>\n")
+		b.WriteString(strings.Join(open, "") + text + strings.Join(close, ""))
+	}
+	return b.String()
+}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "`", "\\`", `*`, `\*`, `_`, `\_`, `~`, `\~`,
+	`[`, `\[`, `]`, `\]`, `<`, `\<`, `>`, `\>`,
+)
+
+// Markdown renders runs as GitHub-flavored Markdown. Markdown has no underline,
+// so underlined text is rendered plain. Emphasis is applied per line, as it cannot span paragraphs.
+// Neighboring runs share the markers they have in common, so that a bold run followed by a
+// bold italic one renders as **a*b***; italics use asterisks, which unlike underscores can
+// emphasize part of a word. Characters that would start a heading, list or quote at the
+// start of a line are escaped.
+func Markdown(runs []nodes.TextRun) string {
+	var w markdownWriter
+	for _, s := range spans(runs, true) {
+		for i, line := range strings.Split(s.text, "\n") {
+			if i > 0 {
+				w.newline()
+			}
+			w.write(line, s.format)
+		}
+	}
+	w.closeTo(0)
+	w.b.WriteString(w.space)
+	return w.b.String()
+}
+
+// A marker is a pair of Markdown delimiters around emphasized or linked text.
+type marker struct {
+	open, close string
+}
+
+// markers returns the markers of f, outermost first.
+func markers(f format) []marker {
+	var ms []marker
+	if f.link != "" {
+		ms = append(ms, marker{"[", "](" + strings.ReplaceAll(f.link, ")", "%29") + ")"})
+	}
+	if f.bold {
+		ms = append(ms, marker{"**", "**"})
+	}
+	if f.italic {
+		ms = append(ms, marker{"*", "*"})
+	}
+	if f.strike {
+		ms = append(ms, marker{"~~", "~~"})
+	}
+	return ms
+}
+
+type markdownWriter struct {
+	b strings.Builder
+	// The markers opened and not yet closed, outermost first.
+	open []marker
+	// Whitespace not yet written, which closing markers must precede.
+	space string
+	// Whether text was written on the current line.
+	midLine bool
+}
+
+// write writes a line of text, or part of one, with the markers of f.
+// Markers must hug the text they emphasize, so whitespace is written outside them.
+func (w *markdownWriter) write(line string, f format) {
+	trimmed := strings.TrimSpace(line)
+	if trimmed == "" {
+		w.space += line
+		return
+	}
+	lead := line[:strings.Index(line, trimmed)]
+	want := markers(f)
+	keep := 0
+	for keep < len(w.open) && slices.Contains(want, w.open[keep]) {
+		keep++
+	}
+	w.closeTo(keep)
+	w.b.WriteString(w.space + lead)
+	w.space = ""
+	for _, m := range want {
+		if !slices.Contains(w.open, m) {
+			w.b.WriteString(m.open)
+			w.open = append(w.open, m)
+		}
+	}
+	text := markdownEscaper.Replace(trimmed)
+	if !w.midLine {
+		text = escapeLineStart(text)
+		w.midLine = true
+	}
+	w.b.WriteString(text)
+	w.space = line[len(lead)+len(trimmed):]
+}
+
+// closeTo closes the open markers after the first n.
+func (w *markdownWriter) closeTo(n int) {
+	for i := len(w.open) - 1; i >= n; i-- {
+		w.b.WriteString(w.open[i].close)
+	}
+	w.open = w.open[:n]
+}
+
+func (w *markdownWriter) newline() {
+	w.closeTo(0)
+	w.b.WriteString(w.space + "\n")
+	w.space = ""
+	w.midLine = false
+}
+
+// escapeLineStart escapes the heading, list and quote markers Markdown recognizes at
+// the start of a line: "#", "-", "+" and the digits and "." or ")" of ordered lists.
+// The "*" and ">" markers are escaped everywhere.
+func escapeLineStart(s string) string {
+	if s == "" {
+		return s
+	}
+	switch s[0] {
+	case '#', '-', '+':
+		return `\` + s
+	}
+	digits := len(s) - len(strings.TrimLeft(s, "0123456789"))
+	if digits > 0 && digits < len(s) && (s[digits] == '.' || s[digits] == ')') {
+		return s[:digits] + `\` + s[digits:]
+	}
+	return s
+}
+
+// ANSI renders runs with ANSI escape sequences for terminals: SGR attributes for
+// emphasis and 24-bit fill colors, and OSC 8 for links.
+func ANSI(runs []nodes.TextRun) string {
+	var b strings.Builder
+	for _, s := range spans(runs, false) {
+		var codes []string
+		if s.bold {
+			codes = append(codes, "1")
+		}
+		if s.italic {
+			codes = append(codes, "3")
+		}
+		if s.underline {
+			codes = append(codes, "4")
+		}
+		if s.strike {
+			codes = append(codes, "9")
+		}
+		if c := s.color; c != nil {
+			codes = append(codes, fmt.Sprintf("38;2;%d;%d;%d", figmatypes.Channel8(c.R), figmatypes.Channel8(c.G), figmatypes.Channel8(c.B)))
+		}
+		if s.link != "" {
+			b.WriteString("\x1b]8;;" + s.link + "\x1b\\")
+		}
+		if len(codes) > 0 {
+			b.WriteString("\x1b[" + strings.Join(codes, ";") + "m")
+		}
+		b.WriteString(s.text)
+		if len(codes) > 0 {
+			b.WriteString("\x1b[0m")
+		}
+		if s.link != "" {
+			b.WriteString("\x1b]8;;\x1b\\")
+		}
+	}
+	return b.String()
+}
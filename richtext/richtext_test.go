@@ -0,0 +1,70 @@
+package richtext
+
+import (
+	"testing"
+
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+func testRuns() []nodes.TextRun {
+	link := &figmatypes.Hyperlink{Type: figmatypes.HyperlinkTypeURL, URL: "https://example.com/a_b"}
+	red := &figmatypes.Color{R: 1, A: 1}
+	return []nodes.TextRun{
+		{Text: "Read "},
+		{Text: "the ", Style: figmatypes.TypeStyle{FontWeight: 700}},
+		{Text: "docs ", Style: figmatypes.TypeStyle{SemanticWeight: figmatypes.SemanticWeightBOLD}},
+		{Text: "here", Style: figmatypes.TypeStyle{Italic: true, Hyperlink: link}},
+		{Text: " & <now>\n", Style: figmatypes.TypeStyle{TextDecoration: figmatypes.TextDecorationUNDERLINE}},
+		{Text: "*old*", Style: figmatypes.TypeStyle{
+			TextDecoration: figmatypes.TextDecorationSTRIKETHROUGH,
+			Fills:          []figmatypes.Paint{{Type: figmatypes.PaintTypeSOLID, Color: red}},
+		}},
+	}
+}
+
+func TestRenderers(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"HTML", HTML(testRuns()),
+			`Read <strong>the docs </strong><a href="https://example.com/a_b"><em>here</em></a><u> &amp; &lt;now&gt;<br>` + "\n" + `</u><s>*old*</s>`},
+		{"Markdown", Markdown(testRuns()),
+			"Read **the docs** [*here*](https://example.com/a_b) & \\<now\\>\n~~\\*old\\*~~"},
+		{"ANSI", ANSI(testRuns()),
+			"Read \x1b[1mthe docs \x1b[0m\x1b]8;;https://example.com/a_b\x1b\\\x1b[3mhere\x1b[0m\x1b]8;;\x1b\\\x1b[4m & <now>\n\x1b[0m\x1b[9;38;2;255;0;0m*old*\x1b[0m"},
+	}
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s:\ngot  %q\nwant %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestMarkdown(t *testing.T) {
+	bold := figmatypes.TypeStyle{FontWeight: 700}
+	boldItalic := figmatypes.TypeStyle{FontWeight: 700, Italic: true}
+	italic := figmatypes.TypeStyle{Italic: true}
+	tests := []struct {
+		runs []nodes.TextRun
+		want string
+	}{
+		// Neighbors share the markers they have in common.
+		{[]nodes.TextRun{{Text: "a", Style: bold}, {Text: "b", Style: boldItalic}}, "**a*b***"},
+		{[]nodes.TextRun{{Text: "a ", Style: boldItalic}, {Text: "b", Style: bold}, {Text: " c"}}, "***a* b** c"},
+		{[]nodes.TextRun{{Text: "a", Style: boldItalic}, {Text: " ", Style: bold}, {Text: "b", Style: italic}}, "***a*** *b*"},
+		// Markers are closed at line ends.
+		{[]nodes.TextRun{{Text: "a\nb", Style: bold}}, "**a**\n**b**"},
+		// Block markers are escaped at the start of lines only.
+		{[]nodes.TextRun{{Text: "# Title\n- one\n + two\n1. three\n10) four\na - b #1. 2"}},
+			"\\# Title\n\\- one\n \\+ two\n1\\. three\n10\\) four\na - b #1. 2"},
+		{[]nodes.TextRun{{Text: "-", Style: bold}, {Text: " 1.5"}}, "**\\-** 1.5"},
+	}
+	for i, tt := range tests {
+		if got := Markdown(tt.runs); got != tt.want {
+			t.Errorf("%d: Markdown = %q, want %q", i, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package figma
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+func TestRoundTrip(t *testing.T) {
+	paths, err := filepath.Glob("testdata/roundtrip/*.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(paths) == 0 {
+		t.Fatal("no test corpus found")
+	}
+	for _, p := range paths {
+		t.Run(filepath.Base(p), func(t *testing.T) {
+			in, err := ioutil.ReadFile(p)
+			if err != nil {
+				t.Fatal(err)
+			}
+			f := &File{}
+			if err := json.Unmarshal(in, f); err != nil {
+				t.Fatal(err)
+			}
+			out, err := json.Marshal(f)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var want, got interface{}
+			if err := json.Unmarshal(in, &want); err != nil {
+				t.Fatal(err)
+			}
+			if err := json.Unmarshal(out, &got); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("round trip differs (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRoundTripModified(t *testing.T) {
+	in, err := ioutil.ReadFile("testdata/roundtrip/design-system.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &File{}
+	if err := json.Unmarshal(in, f); err != nil {
+		t.Fatal(err)
+	}
+	canvas := f.Document.Children[0].(*nodes.Canvas)
+	photo := nodes.Find(canvas, func(n nodes.Node) bool { return n.GetID() == "1:5" })
+	if r, ok := photo.(*nodes.Rectangle); !ok || len(r.RectangleCornerRadii) != 4 || r.Fills[0].ImageRef != "abc123" || r.Fills[0].ImageTransform == nil {
+		t.Errorf("corner radii and image paint fields not decoded: %+v", photo)
+	}
+	button := canvas.Children[0].(*nodes.Component)
+	button.Name = "Primary Button"
+	button.Effects = nil
+	f.Name = "Renamed"
+
+	out, err := json.Marshal(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got struct {
+		Name       string `json:"name"`
+		EditorType string `json:"editorType"`
+		Document   struct {
+			Children []struct {
+				Children []map[string]interface{} `json:"children"`
+			} `json:"children"`
+		} `json:"document"`
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != "Renamed" || got.EditorType != "figma" {
+		t.Errorf("got name %q and editor type %q", got.Name, got.EditorType)
+	}
+	n := got.Document.Children[0].Children[0]
+	if n["name"] != "Primary Button" {
+		t.Errorf("got node name %v, want %q", n["name"], "Primary Button")
+	}
+	if effects, _ := n["effects"].([]interface{}); len(effects) != 0 {
+		t.Errorf("cleared effects were written back: %v", n["effects"])
+	}
+	if n["layoutMode"] != "HORIZONTAL" {
+		t.Errorf("unmodeled field lost: got layoutMode %v", n["layoutMode"])
+	}
+}
+
+func TestFigJamDecoding(t *testing.T) {
+	in, err := ioutil.ReadFile("testdata/roundtrip/figjam.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &File{}
+	if err := json.Unmarshal(in, f); err != nil {
+		t.Fatal(err)
+	}
+	board := f.Document.Children[0].(*nodes.Canvas)
+	section, ok := board.Children[0].(*nodes.Section)
+	if !ok {
+		t.Fatalf("got %T, want *nodes.Section", board.Children[0])
+	}
+	sticky, ok := section.GetChildren()[0].(*nodes.Sticky)
+	if !ok {
+		t.Fatalf("got %T, want *nodes.Sticky", section.GetChildren()[0])
+	}
+	if sticky.Characters != "Ship it" {
+		t.Errorf("got characters %q", sticky.Characters)
+	}
+	if _, ok := board.Children[1].(*nodes.Connector); !ok {
+		t.Errorf("got %T, want *nodes.Connector", board.Children[1])
+	}
+}
+
+func TestDecodeMalformedNode(t *testing.T) {
+	in := `{"document":{"id":"0:0","type":"DOCUMENT","children":[
+		{"id":"0:1","type":"CANVAS","children":[{"id":"1:1","type":"TEXT","characters":5}]}]}}`
+	if err := json.Unmarshal([]byte(in), &File{}); err == nil {
+		t.Error("decoded a TEXT node whose characters are a number")
+	}
+}
+
+func TestStrictDecode(t *testing.T) {
+	in := []byte(`{"document":{"id":"0:0","type":"DOCUMENT","children":[
+		{"id":"0:1","type":"CANVAS","children":[{"id":"1:1","type":"FRAME","blendMode":"FUTURE"}]},
+		{"id":"0:2","type":"FUTURE_NODE"}]}}`)
+	if err := figmatypes.Decode(in, &File{}); err != nil {
+		t.Fatal(err)
+	}
+	var enumErr *figmatypes.UnknownEnumError
+	err := figmatypes.Decode(in, &File{}, figmatypes.Strict())
+	if !errors.As(err, &enumErr) || enumErr.Type != "BlendMode" || enumErr.Path != "document.children[0].children[0].blendMode" {
+		t.Errorf("strict decode of a nested node: got %v", err)
+	}
+	var c nodes.Children
+	err = figmatypes.Decode([]byte(`[{"id":"0:2","type":"FUTURE_NODE"}]`), &c, figmatypes.Strict())
+	if !errors.As(err, &enumErr) || enumErr.Type != "NodeType" || enumErr.Path != "[0].type" {
+		t.Errorf("strict decode of an unknown node: got %v", err)
+	}
+}
+
+func TestRoundTripResizedList(t *testing.T) {
+	in := `[{"id":"1:1","type":"FRAME","fills":[
+		{"type":"SOLID","color":{"r":1,"g":0,"b":0,"a":1},"futureField":"kept"}]}]`
+	var c nodes.Children
+	if err := json.Unmarshal([]byte(in), &c); err != nil {
+		t.Fatal(err)
+	}
+	f := c[0].(*nodes.Frame)
+	f.Fills = append(f.Fills, f.Fills[0])
+	half := 0.5
+	f.Fills[1].Opacity = &half
+	out, err := json.Marshal(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var got []struct {
+		Fills []map[string]interface{} `json:"fills"`
+	}
+	if err := json.Unmarshal(out, &got); err != nil {
+		t.Fatal(err)
+	}
+	if fills := got[0].Fills; len(fills) != 2 || fills[0]["futureField"] != "kept" || fills[1]["opacity"] != 0.5 {
+		t.Errorf("got fills %v, want the first to keep its unmodeled field", fills)
+	}
+}
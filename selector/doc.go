@@ -0,0 +1,32 @@
+// Package selector finds nodes with CSS-like selectors.
+//
+// Supported syntax:
+//
+//	FRAME, TEXT       node type; * matches any type
+//	#1:2              node ID
+//	[name="Button"]   attribute equals
+//	[name!="Button"]  attribute does not equal
+//	[name^="Btn"]     attribute starts with
+//	[name$="/Hover"]  attribute ends with
+//	[name*="icon"]    attribute contains
+//	[name~="^Icon/"]  attribute matches a regular expression
+//	[characters]      attribute is present and not empty
+//	A B               B is a descendant of A
+//	A > B             B is a child of A
+//	A, B              either A or B
+//
+// Attributes are looked up in the node's JSON encoding, so any field can be matched,
+// including nested fields with dotted keys such as [style.fontSize=16]. Children are
+// not attributes; match them with combinators or :has().
+// The visible attribute defaults to true when absent.
+//
+// Pseudo-selectors:
+//
+//	:instance-of(Button)  instances of the component or component set named or with the ID Button
+//	:not(sel)             nodes not matching sel
+//	:has(sel)             nodes with a descendant matching sel; :has(> sel) only considers children
+//	:visible, :hidden     nodes that are shown or hidden, including through an ancestor
+//	:first-child          nodes that are the first child of their parent
+//	:last-child           nodes that are the last child of their parent
+//	:empty                nodes without children
+package selector
@@ -0,0 +1,307 @@
+package selector
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// parser is a recursive descent parser over a selector string.
+type parser struct {
+	s   string
+	pos int
+}
+
+// SyntaxError describes a malformed selector.
+type SyntaxError struct {
+	Selector string
+	Offset   int
+	Msg      string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("selector: %s at offset %d in %q", e.Msg, e.Offset, e.Selector)
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Selector: p.s, Offset: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) eof() bool { return p.pos >= len(p.s) }
+
+func (p *parser) peek() byte {
+	if p.eof() {
+		return 0
+	}
+	return p.s[p.pos]
+}
+
+func (p *parser) skipSpace() bool {
+	start := p.pos
+	for !p.eof() && isSpace(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
+
+func isIdent(c byte) bool {
+	return c == '_' || c == '-' || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z') || ('0' <= c && c <= '9')
+}
+
+// list parses a comma separated list of complex selectors, stopping at end or at an unmatched ')'.
+// If relative is set, selectors may start with '>' to only match children of the scoping node.
+func (p *parser) list(relative bool) ([]complexSel, error) {
+	var result []complexSel
+	for {
+		p.skipSpace()
+		child := false
+		if relative && p.peek() == '>' {
+			p.pos++
+			p.skipSpace()
+			child = true
+		}
+		c, err := p.complex()
+		if err != nil {
+			return nil, err
+		}
+		c.relative, c.child = relative, child
+		result = append(result, c)
+		p.skipSpace()
+		if p.peek() != ',' {
+			return result, nil
+		}
+		p.pos++
+	}
+}
+
+func (p *parser) complex() (complexSel, error) {
+	var c complexSel
+	for {
+		cs, err := p.compound()
+		if err != nil {
+			return c, err
+		}
+		c.parts = append(c.parts, cs)
+		space := p.skipSpace()
+		switch {
+		case p.peek() == '>':
+			p.pos++
+			p.skipSpace()
+			c.combinators = append(c.combinators, '>')
+		case space && !p.eof() && p.peek() != ',' && p.peek() != ')':
+			c.combinators = append(c.combinators, ' ')
+		default:
+			return c, nil
+		}
+	}
+}
+
+func (p *parser) compound() (compound, error) {
+	var c compound
+	start := p.pos
+	if p.peek() == '*' {
+		p.pos++
+	} else if isIdent(p.peek()) {
+		c.typ = strings.ToUpper(p.ident())
+	}
+	for !p.eof() {
+		switch p.peek() {
+		case '#':
+			p.pos++
+			id := p.id()
+			if id == "" {
+				return c, p.errorf("expected node ID")
+			}
+			c.conds = append(c.conds, idCond(id))
+		case '[':
+			cond, err := p.attr()
+			if err != nil {
+				return c, err
+			}
+			c.conds = append(c.conds, cond)
+		case ':':
+			cond, err := p.pseudo()
+			if err != nil {
+				return c, err
+			}
+			c.conds = append(c.conds, cond)
+		default:
+			goto done
+		}
+	}
+done:
+	if p.pos == start {
+		if p.eof() {
+			return c, p.errorf("unexpected end of selector")
+		}
+		return c, p.errorf("unexpected %q", p.peek())
+	}
+	return c, nil
+}
+
+func (p *parser) ident() string {
+	start := p.pos
+	for !p.eof() && isIdent(p.s[p.pos]) {
+		p.pos++
+	}
+	return p.s[start:p.pos]
+}
+
+// id parses a node ID such as "1:2" or "I1:4;1:3". A colon is part of the ID only if followed by a digit.
+func (p *parser) id() string {
+	start := p.pos
+	for !p.eof() {
+		c := p.s[p.pos]
+		if c == ':' && p.pos+1 < len(p.s) && '0' <= p.s[p.pos+1] && p.s[p.pos+1] <= '9' {
+			p.pos++
+			continue
+		}
+		if c == ';' || isIdent(c) {
+			p.pos++
+			continue
+		}
+		break
+	}
+	return p.s[start:p.pos]
+}
+
+func (p *parser) attr() (cond, error) {
+	p.pos++ // [
+	p.skipSpace()
+	start := p.pos
+	for !p.eof() && (isIdent(p.peek()) || p.peek() == '.') {
+		p.pos++
+	}
+	key := p.s[start:p.pos]
+	if key == "" {
+		return nil, p.errorf("expected attribute name")
+	}
+	p.skipSpace()
+	a := &attrCond{key: key}
+	if p.peek() == ']' {
+		p.pos++
+		return a, nil
+	}
+	for _, op := range []string{"=", "!=", "^=", "$=", "*=", "~="} {
+		if strings.HasPrefix(p.s[p.pos:], op) {
+			a.op = op
+		}
+	}
+	if a.op == "" {
+		return nil, p.errorf("expected attribute operator")
+	}
+	p.pos += len(a.op)
+	p.skipSpace()
+	v, err := p.value()
+	if err != nil {
+		return nil, err
+	}
+	a.value = v
+	if a.op == "~=" {
+		re, err := regexp.Compile(v)
+		if err != nil {
+			return nil, p.errorf("invalid regular expression: %v", err)
+		}
+		a.re = re
+	}
+	p.skipSpace()
+	if p.peek() != ']' {
+		return nil, p.errorf("expected ]")
+	}
+	p.pos++
+	return a, nil
+}
+
+// value parses a quoted string or a bare word.
+func (p *parser) value() (string, error) {
+	q := p.peek()
+	if q != '"' && q != '\'' {
+		start := p.pos
+		for !p.eof() && p.peek() != ']' && p.peek() != ')' && !isSpace(p.peek()) {
+			p.pos++
+		}
+		return p.s[start:p.pos], nil
+	}
+	p.pos++
+	var b strings.Builder
+	for !p.eof() {
+		c := p.s[p.pos]
+		p.pos++
+		switch {
+		case c == q:
+			return b.String(), nil
+		case c == '\\' && !p.eof():
+			b.WriteByte(p.s[p.pos])
+			p.pos++
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", p.errorf("unterminated string")
+}
+
+func (p *parser) pseudo() (cond, error) {
+	p.pos++ // :
+	name := p.ident()
+	switch name {
+	case "visible":
+		return visibleCond(true), nil
+	case "hidden":
+		return visibleCond(false), nil
+	case "first-child":
+		return childCond(true), nil
+	case "last-child":
+		return childCond(false), nil
+	case "empty":
+		return emptyCond{}, nil
+	case "not", "has":
+		if p.peek() != '(' {
+			return nil, p.errorf("expected ( after :%s", name)
+		}
+		p.pos++
+		list, err := p.list(name == "has")
+		if err != nil {
+			return nil, err
+		}
+		if p.peek() != ')' {
+			return nil, p.errorf("expected )")
+		}
+		p.pos++
+		if name == "not" {
+			return notCond{list}, nil
+		}
+		return hasCond{list}, nil
+	case "instance-of":
+		if p.peek() != '(' {
+			return nil, p.errorf("expected ( after :instance-of")
+		}
+		p.pos++
+		p.skipSpace()
+		var arg string
+		if q := p.peek(); q == '"' || q == '\'' {
+			v, err := p.value()
+			if err != nil {
+				return nil, err
+			}
+			arg = v
+		} else {
+			end := strings.IndexByte(p.s[p.pos:], ')')
+			if end < 0 {
+				return nil, p.errorf("expected )")
+			}
+			arg = strings.TrimSpace(p.s[p.pos : p.pos+end])
+			p.pos += end
+		}
+		p.skipSpace()
+		if p.peek() != ')' {
+			return nil, p.errorf("expected )")
+		}
+		p.pos++
+		return instanceOfCond(arg), nil
+	}
+	return nil, p.errorf("unknown pseudo-selector :%s", name)
+}
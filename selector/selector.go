@@ -0,0 +1,380 @@
+package selector
+
+import (
+	"encoding/json"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/tmc/figma/nodes"
+)
+
+// Selector is a compiled selector. It is safe for concurrent use.
+type Selector struct {
+	src  string
+	alts []complexSel
+}
+
+// Compile parses a selector.
+func Compile(s string) (*Selector, error) {
+	p := &parser{s: s}
+	alts, err := p.list(false)
+	if err != nil {
+		return nil, err
+	}
+	if !p.eof() {
+		return nil, p.errorf("unexpected %q", p.peek())
+	}
+	return &Selector{src: s, alts: alts}, nil
+}
+
+// MustCompile is like Compile but panics if the selector cannot be parsed.
+func MustCompile(s string) *Selector {
+	sel, err := Compile(s)
+	if err != nil {
+		panic(err)
+	}
+	return sel
+}
+
+// String returns the source of the selector.
+func (s *Selector) String() string {
+	return s.src
+}
+
+// Select returns every node in the tree rooted at root, including root, that matches the selector, in document order.
+func (s *Selector) Select(root nodes.Node) []nodes.Node {
+	var result []nodes.Node
+	x := newCtx(root)
+	for path, n := range nodes.All(root) {
+		if x.matchList(s.alts, path, n) {
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// SelectOne returns the first node in document order that matches the selector, or nil.
+func (s *Selector) SelectOne(root nodes.Node) nodes.Node {
+	x := newCtx(root)
+	for path, n := range nodes.All(root) {
+		if x.matchList(s.alts, path, n) {
+			return n
+		}
+	}
+	return nil
+}
+
+// Match reports whether n, reached from the root of its tree through path, matches the selector.
+func (s *Selector) Match(path nodes.Path, n nodes.Node) bool {
+	root := n
+	if len(path) > 0 {
+		root = path[0]
+	}
+	return newCtx(root).matchList(s.alts, path, n)
+}
+
+// Select compiles sel and returns the nodes under root that match it.
+func Select(root nodes.Node, sel string) ([]nodes.Node, error) {
+	s, err := Compile(sel)
+	if err != nil {
+		return nil, err
+	}
+	return s.Select(root), nil
+}
+
+// SelectOne compiles sel and returns the first node under root that matches it, or nil.
+func SelectOne(root nodes.Node, sel string) (nodes.Node, error) {
+	s, err := Compile(sel)
+	if err != nil {
+		return nil, err
+	}
+	return s.SelectOne(root), nil
+}
+
+// complexSel is a chain of compound selectors joined by combinators.
+type complexSel struct {
+	parts []compound
+	// combinators[i] joins parts[i] and parts[i+1]: ' ' for descendant, '>' for child.
+	combinators []byte
+	// relative is set for selectors in :has, which are matched against paths
+	// starting at the scoping node: their compounds only match its descendants.
+	relative bool
+	// child is set for relative selectors that start with '>'.
+	child bool
+}
+
+// compound is a type selector with additional conditions.
+type compound struct {
+	typ   string
+	conds []cond
+}
+
+type cond interface {
+	match(x *ctx, path nodes.Path, n nodes.Node) bool
+}
+
+// ctx holds state shared by the matches of a single Select call.
+type ctx struct {
+	root nodes.Node
+	// JSON encodings of nodes whose attributes have been looked up.
+	attrs map[nodes.Node]map[string]interface{}
+	// names of components and their component sets, keyed by component ID. Built on first use.
+	components map[string][]string
+}
+
+func newCtx(root nodes.Node) *ctx {
+	return &ctx{root: root, attrs: make(map[nodes.Node]map[string]interface{})}
+}
+
+func (x *ctx) matchList(list []complexSel, path nodes.Path, n nodes.Node) bool {
+	for i := range list {
+		if x.matchComplex(&list[i], len(list[i].parts)-1, path, n) {
+			return true
+		}
+	}
+	return false
+}
+
+func (x *ctx) matchComplex(c *complexSel, i int, path nodes.Path, n nodes.Node) bool {
+	if !x.matchCompound(&c.parts[i], path, n) {
+		return false
+	}
+	if i == 0 {
+		// paths within :has start at the scoping node.
+		return !c.child || len(path) == 1
+	}
+	// the scoping node of a relative selector is not a candidate for its compounds.
+	first := 0
+	if c.relative {
+		first = 1
+	}
+	switch c.combinators[i-1] {
+	case '>':
+		if len(path) <= first {
+			return false
+		}
+		return x.matchComplex(c, i-1, path[:len(path)-1], path[len(path)-1])
+	default:
+		for j := len(path) - 1; j >= first; j-- {
+			if x.matchComplex(c, i-1, path[:j], path[j]) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func (x *ctx) matchCompound(c *compound, path nodes.Path, n nodes.Node) bool {
+	if c.typ != "" && string(n.GetType()) != c.typ {
+		return false
+	}
+	for _, cond := range c.conds {
+		if !cond.match(x, path, n) {
+			return false
+		}
+	}
+	return true
+}
+
+// attr looks up a possibly dotted attribute key on a node.
+func (x *ctx) attr(n nodes.Node, key string) (interface{}, bool) {
+	switch key {
+	case "id":
+		return n.GetID(), true
+	case "name":
+		return n.GetName(), true
+	case "type":
+		return string(n.GetType()), true
+	case "visible":
+		v := n.GetVisible()
+		return v == nil || *v, true
+	}
+	m, ok := x.attrs[n]
+	if !ok {
+		if b, err := nodes.Marshal(withoutChildren(n)); err == nil {
+			json.Unmarshal(b, &m)
+		}
+		x.attrs[n] = m
+	}
+	var v interface{} = m
+	for _, k := range strings.Split(key, ".") {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		if v, ok = obj[k]; !ok {
+			return nil, false
+		}
+	}
+	return v, true
+}
+
+// withoutChildren returns a shallow copy of n without its children, so that
+// looking up its attributes does not encode its subtree.
+func withoutChildren(n nodes.Node) nodes.Node {
+	v := reflect.ValueOf(n)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return n
+	}
+	c := reflect.New(v.Elem().Type())
+	c.Elem().Set(v.Elem())
+	if f := c.Elem().FieldByName("Children"); f.IsValid() && f.CanSet() {
+		f.Set(reflect.Zero(f.Type()))
+	}
+	return c.Interface().(nodes.Node)
+}
+
+func (x *ctx) componentNames(id string) []string {
+	if x.components == nil {
+		x.components = make(map[string][]string)
+		for path, n := range nodes.All(x.root) {
+			c, ok := n.(*nodes.Component)
+			if !ok {
+				continue
+			}
+			names := []string{c.Name}
+			if set, ok := path.Parent().(*nodes.ComponentSet); ok {
+				names = append(names, set.Name, set.ID)
+			}
+			x.components[c.ID] = names
+		}
+	}
+	return x.components[id]
+}
+
+type idCond string
+
+func (c idCond) match(x *ctx, path nodes.Path, n nodes.Node) bool {
+	return n.GetID() == string(c)
+}
+
+type attrCond struct {
+	key   string
+	op    string
+	value string
+	re    *regexp.Regexp
+}
+
+func (c *attrCond) match(x *ctx, path nodes.Path, n nodes.Node) bool {
+	v, ok := x.attr(n, c.key)
+	if c.op == "" {
+		return ok && v != nil && v != "" && v != false
+	}
+	s := stringify(v)
+	switch c.op {
+	case "=":
+		return ok && equal(v, s, c.value)
+	case "!=":
+		return !ok || !equal(v, s, c.value)
+	case "^=":
+		return ok && strings.HasPrefix(s, c.value)
+	case "$=":
+		return ok && strings.HasSuffix(s, c.value)
+	case "*=":
+		return ok && strings.Contains(s, c.value)
+	case "~=":
+		return ok && c.re.MatchString(s)
+	}
+	return false
+}
+
+func stringify(v interface{}) string {
+	switch v := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case bool:
+		return strconv.FormatBool(v)
+	case float64:
+		return strconv.FormatFloat(v, 'g', -1, 64)
+	}
+	b, _ := json.Marshal(v)
+	return string(b)
+}
+
+func equal(v interface{}, s, want string) bool {
+	if f, ok := v.(float64); ok {
+		if w, err := strconv.ParseFloat(want, 64); err == nil {
+			return f == w
+		}
+	}
+	return s == want
+}
+
+type visibleCond bool
+
+func (c visibleCond) match(x *ctx, path nodes.Path, n nodes.Node) bool {
+	hidden := func(m nodes.Node) bool {
+		v := m.GetVisible()
+		return v != nil && !*v
+	}
+	visible := !hidden(n)
+	for _, m := range path {
+		if hidden(m) {
+			visible = false
+		}
+	}
+	return visible == bool(c)
+}
+
+type childCond bool
+
+func (c childCond) match(x *ctx, path nodes.Path, n nodes.Node) bool {
+	p, ok := path.Parent().(nodes.Parent)
+	if !ok {
+		return false
+	}
+	children := p.GetChildren()
+	if len(children) == 0 {
+		return false
+	}
+	if c {
+		return children[0] == n
+	}
+	return children[len(children)-1] == n
+}
+
+type emptyCond struct{}
+
+func (emptyCond) match(x *ctx, path nodes.Path, n nodes.Node) bool {
+	p, ok := n.(nodes.Parent)
+	return !ok || len(p.GetChildren()) == 0
+}
+
+type notCond struct{ list []complexSel }
+
+func (c notCond) match(x *ctx, path nodes.Path, n nodes.Node) bool {
+	return !x.matchList(c.list, path, n)
+}
+
+type hasCond struct{ list []complexSel }
+
+func (c hasCond) match(x *ctx, path nodes.Path, n nodes.Node) bool {
+	for p, m := range nodes.All(n) {
+		if m != n && x.matchList(c.list, p, m) {
+			return true
+		}
+	}
+	return false
+}
+
+type instanceOfCond string
+
+func (c instanceOfCond) match(x *ctx, path nodes.Path, n nodes.Node) bool {
+	i, ok := n.(*nodes.Instance)
+	if !ok {
+		return false
+	}
+	if i.ComponentID == string(c) {
+		return true
+	}
+	for _, name := range x.componentNames(i.ComponentID) {
+		if name == string(c) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,108 @@
+package selector
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma/nodes"
+)
+
+const tree = `[{
+  "id": "0:0", "type": "DOCUMENT", "name": "Document", "children": [
+    {"id": "0:1", "type": "CANVAS", "name": "Page", "children": [
+      {"id": "1:0", "type": "COMPONENT_SET", "name": "Button", "children": [
+        {"id": "1:1", "type": "COMPONENT", "name": "Size=Small", "children": [
+          {"id": "1:2", "type": "TEXT", "name": "Label", "characters": "Go", "style": {"fontSize": 12}}
+        ]},
+        {"id": "1:3", "type": "COMPONENT", "name": "Size=Large"}
+      ]},
+      {"id": "2:0", "type": "FRAME", "name": "Screen/Home", "children": [
+        {"id": "2:1", "type": "INSTANCE", "name": "Buy", "componentId": "1:3", "children": [
+          {"id": "I2:1;1:2", "type": "TEXT", "name": "Label", "characters": "Buy now", "style": {"fontSize": 16}}
+        ]},
+        {"id": "2:2", "type": "TEXT", "name": "Title", "visible": false, "characters": ""},
+        {"id": "2:3", "type": "GROUP", "name": "Hidden group", "visible": false, "children": [
+          {"id": "2:4", "type": "RECTANGLE", "name": "Icon/close", "opacity": 0.5}
+        ]}
+      ]}
+    ]}
+  ]
+}]`
+
+func TestSelect(t *testing.T) {
+	var c nodes.Children
+	if err := json.Unmarshal([]byte(tree), &c); err != nil {
+		t.Fatal(err)
+	}
+	root := c[0]
+	cases := []struct {
+		sel  string
+		want string
+	}{
+		{"TEXT", "1:2 I2:1;1:2 2:2"},
+		{"text", "1:2 I2:1;1:2 2:2"},
+		{"#2:1", "2:1"},
+		{"#I2:1;1:2", "I2:1;1:2"},
+		{"FRAME > TEXT", "2:2"},
+		{"FRAME TEXT", "I2:1;1:2 2:2"},
+		{"CANVAS > FRAME > INSTANCE > TEXT", "I2:1;1:2"},
+		{`[name="Label"]`, "1:2 I2:1;1:2"},
+		{`[name^="Screen/"]`, "2:0"},
+		{`[name$="close"]`, "2:4"},
+		{`[name*="group"]`, "2:3"},
+		{`[name~="^Size=(Small|Large)$"]`, "1:1 1:3"},
+		{`TEXT[visible=false]`, "2:2"},
+		{`TEXT[characters]`, "1:2 I2:1;1:2"},
+		{`[style.fontSize=16]`, "I2:1;1:2"},
+		{`[opacity=0.5]`, "2:4"},
+		{`[componentId="1:3"]`, "2:1"},
+		{`[children]`, ""},
+		{":instance-of(Button)", "2:1"},
+		{`:instance-of("Size=Large")`, "2:1"},
+		{":instance-of(1:3)", "2:1"},
+		{":instance-of(Card)", ""},
+		{"TEXT:not(COMPONENT TEXT)", "I2:1;1:2 2:2"},
+		{`FRAME:has(> INSTANCE)`, "2:0"},
+		{`FRAME:has(> RECTANGLE)`, ""},
+		{`FRAME:has(INSTANCE)`, "2:0"},
+		{`FRAME:has(FRAME INSTANCE)`, ""},
+		{`FRAME:has(INSTANCE TEXT)`, "2:0"},
+		{`FRAME:has(> GROUP > RECTANGLE)`, "2:0"},
+		{`GROUP:has(GROUP > RECTANGLE)`, ""},
+		{`CANVAS:has(FRAME GROUP)`, "0:1"},
+		{"RECTANGLE:hidden", "2:4"},
+		{"TEXT:visible", "1:2 I2:1;1:2"},
+		{"COMPONENT:first-child", "1:1"},
+		{"COMPONENT:last-child", "1:3"},
+		{"COMPONENT:empty", "1:3"},
+		{"RECTANGLE, INSTANCE", "2:1 2:4"},
+	}
+	for _, tt := range cases {
+		t.Run(tt.sel, func(t *testing.T) {
+			s, err := Compile(tt.sel)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, n := range s.Select(root) {
+				got = append(got, n.GetID())
+			}
+			if strings.Join(got, " ") != tt.want {
+				t.Errorf("got %q, want %q", strings.Join(got, " "), tt.want)
+			}
+		})
+	}
+
+	if n, err := SelectOne(root, "TEXT"); err != nil || n.GetID() != "1:2" {
+		t.Errorf("SelectOne = %v, %v", n, err)
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, sel := range []string{"", "FRAME >", "[name", `[name="x]`, "[name%x]", ":bogus", ":not(TEXT", "[name~=\"(\"]", "FRAME)"} {
+		if _, err := Compile(sel); err == nil {
+			t.Errorf("Compile(%q) succeeded, want error", sel)
+		}
+	}
+}
@@ -0,0 +1,6 @@
+// Package spatial provides an R-tree over the absolute bounding boxes of the
+// nodes on a page, answering point, rectangle and nearest-neighbor queries.
+//
+// Hidden nodes and their descendants are not indexed, and the bounds of nodes
+// inside frames that clip their content are clipped to the frame.
+package spatial
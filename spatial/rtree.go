@@ -0,0 +1,125 @@
+package spatial
+
+import (
+	"math"
+	"sort"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// maxEntries is the maximum number of children of a tree node.
+const maxEntries = 16
+
+// rect is an axis-aligned rectangle stored by its edges.
+type rect struct {
+	minX, minY, maxX, maxY float64
+}
+
+func fromRectangle(r figmatypes.Rectangle) rect {
+	return rect{r.X, r.Y, r.X + r.Width, r.Y + r.Height}
+}
+
+func (r rect) rectangle() figmatypes.Rectangle {
+	return figmatypes.Rectangle{X: r.minX, Y: r.minY, Width: r.maxX - r.minX, Height: r.maxY - r.minY}
+}
+
+func (r rect) union(s rect) rect {
+	return rect{math.Min(r.minX, s.minX), math.Min(r.minY, s.minY), math.Max(r.maxX, s.maxX), math.Max(r.maxY, s.maxY)}
+}
+
+func (r rect) intersect(s rect) (rect, bool) {
+	i := rect{math.Max(r.minX, s.minX), math.Max(r.minY, s.minY), math.Min(r.maxX, s.maxX), math.Min(r.maxY, s.maxY)}
+	return i, i.minX <= i.maxX && i.minY <= i.maxY
+}
+
+func (r rect) intersects(s rect) bool {
+	return r.minX <= s.maxX && s.minX <= r.maxX && r.minY <= s.maxY && s.minY <= r.maxY
+}
+
+func (r rect) contains(s rect) bool {
+	return r.minX <= s.minX && s.maxX <= r.maxX && r.minY <= s.minY && s.maxY <= r.maxY
+}
+
+func (r rect) containsPoint(p figmatypes.Vector) bool {
+	return r.minX <= p.X && p.X <= r.maxX && r.minY <= p.Y && p.Y <= r.maxY
+}
+
+// distance returns the distance from p to the nearest point of r, zero if p is inside.
+func (r rect) distance(p figmatypes.Vector) float64 {
+	dx := math.Max(0, math.Max(r.minX-p.X, p.X-r.maxX))
+	dy := math.Max(0, math.Max(r.minY-p.Y, p.Y-r.maxY))
+	return math.Hypot(dx, dy)
+}
+
+// treeNode is a node of the R-tree. Leaves hold entry indexes, inner nodes hold children.
+type treeNode struct {
+	bounds   rect
+	children []*treeNode
+	entries  []int
+}
+
+// build bulk-loads a tree with the Sort-Tile-Recursive algorithm.
+func build(bounds []rect) *treeNode {
+	if len(bounds) == 0 {
+		return nil
+	}
+	level := make([]*treeNode, len(bounds))
+	for i, b := range bounds {
+		level[i] = &treeNode{bounds: b, entries: []int{i}}
+	}
+	leaves := true
+	for len(level) > 1 || leaves {
+		level = pack(level, leaves)
+		leaves = false
+	}
+	return level[0]
+}
+
+// pack groups nodes into parents of at most maxEntries, tiling them first by x and then by y.
+func pack(level []*treeNode, leaves bool) []*treeNode {
+	center := func(n *treeNode, y bool) float64 {
+		if y {
+			return n.bounds.minY + n.bounds.maxY
+		}
+		return n.bounds.minX + n.bounds.maxX
+	}
+	sort.SliceStable(level, func(i, j int) bool { return center(level[i], false) < center(level[j], false) })
+	parents := int(math.Ceil(float64(len(level)) / maxEntries))
+	sliceSize := int(math.Ceil(math.Sqrt(float64(parents)))) * maxEntries
+
+	var out []*treeNode
+	for start := 0; start < len(level); start += sliceSize {
+		slice := level[start:min(start+sliceSize, len(level))]
+		sort.SliceStable(slice, func(i, j int) bool { return center(slice[i], true) < center(slice[j], true) })
+		for i := 0; i < len(slice); i += maxEntries {
+			group := slice[i:min(i+maxEntries, len(slice))]
+			p := &treeNode{bounds: group[0].bounds}
+			for _, n := range group {
+				p.bounds = p.bounds.union(n.bounds)
+				if leaves {
+					p.entries = append(p.entries, n.entries...)
+				} else {
+					p.children = append(p.children, n)
+				}
+			}
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// search calls fn for every entry whose bounds satisfy match,
+// descending only into tree nodes whose bounds satisfy prune.
+func (n *treeNode) search(prune, match func(rect) bool, bounds []rect, fn func(int)) {
+	if n == nil || !prune(n.bounds) {
+		return
+	}
+	for _, c := range n.children {
+		c.search(prune, match, bounds, fn)
+	}
+	for _, e := range n.entries {
+		if match(bounds[e]) {
+			fn(e)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+package spatial
+
+import (
+	"container/heap"
+	"sort"
+
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// Item is an indexed node.
+type Item struct {
+	Node nodes.Node
+	// The ancestors of the node, root first.
+	Path nodes.Path
+	// The absolute bounding box of the node, clipped by its ancestors.
+	Bounds figmatypes.Rectangle
+}
+
+// Index is a read-only spatial index of a node tree. It is not updated if the tree changes.
+type Index struct {
+	items  []Item
+	bounds []rect
+	byID   map[string]int
+	tree   *treeNode
+}
+
+// New indexes the visible nodes under root, usually a canvas, that have an absolute bounding box.
+func New(root nodes.Node) *Index {
+	x := &Index{byID: make(map[string]int)}
+	// The clip rectangle applied to the children of each node, if any.
+	clips := make(map[nodes.Node]rect)
+	nodes.Walk(root, func(path nodes.Path, n nodes.Node) error {
+		if v := n.GetVisible(); v != nil && !*v {
+			return nodes.SkipChildren
+		}
+		clip, clipped := clips[path.Parent()]
+		if clipped {
+			clips[n] = clip
+		}
+		b, ok := n.(nodes.Bounded)
+		if !ok || b.GetAbsoluteBoundingBox() == (figmatypes.Rectangle{}) {
+			return nil
+		}
+		r := fromRectangle(b.GetAbsoluteBoundingBox())
+		if clipped {
+			var ok bool
+			if r, ok = r.intersect(clip); !ok {
+				// Entirely clipped away, along with its descendants.
+				return nodes.SkipChildren
+			}
+		}
+		if c, ok := n.(nodes.Clipper); ok && c.GetClipsContent() {
+			clips[n] = r
+		}
+		x.byID[n.GetID()] = len(x.items)
+		x.items = append(x.items, Item{Node: n, Path: path.Clone(), Bounds: r.rectangle()})
+		x.bounds = append(x.bounds, r)
+		return nil
+	})
+	x.tree = build(x.bounds)
+	return x
+}
+
+// Len returns the number of indexed nodes.
+func (x *Index) Len() int {
+	return len(x.items)
+}
+
+// Item returns the indexed node with the given ID.
+func (x *Index) Item(id string) (Item, bool) {
+	i, ok := x.byID[id]
+	if !ok {
+		return Item{}, false
+	}
+	return x.items[i], true
+}
+
+// collect returns the items at the given indexes in document order, or in reverse if topFirst is set.
+func (x *Index) collect(idx []int, topFirst bool) []Item {
+	if topFirst {
+		sort.Sort(sort.Reverse(sort.IntSlice(idx)))
+	} else {
+		sort.Ints(idx)
+	}
+	out := make([]Item, len(idx))
+	for i, j := range idx {
+		out[i] = x.items[j]
+	}
+	return out
+}
+
+func (x *Index) search(prune, match func(rect) bool) []int {
+	var idx []int
+	x.tree.search(prune, match, x.bounds, func(i int) { idx = append(idx, i) })
+	return idx
+}
+
+// At returns the nodes whose bounds contain pt, topmost first.
+// Nodes later in the document are drawn above earlier ones.
+func (x *Index) At(pt figmatypes.Vector) []Item {
+	in := func(r rect) bool { return r.containsPoint(pt) }
+	return x.collect(x.search(in, in), true)
+}
+
+// Pin returns the absolute position of a comment pin. Pins attached to a node are offset from the
+// origin of the node's bounding box; it reports false if that node is not in the index.
+func (x *Index) Pin(meta figmatypes.VectorOrFrameOffset) (figmatypes.Vector, bool) {
+	if meta.NodeID == "" {
+		return figmatypes.Vector{X: meta.X, Y: meta.Y}, true
+	}
+	i, ok := x.byID[meta.NodeID]
+	if !ok {
+		return figmatypes.Vector{}, false
+	}
+	origin := x.items[i].Node.(nodes.Bounded).GetAbsoluteBoundingBox()
+	pt := figmatypes.Vector{X: origin.X, Y: origin.Y}
+	if meta.NodeOffset != nil {
+		pt.X += meta.NodeOffset.X
+		pt.Y += meta.NodeOffset.Y
+	}
+	return pt, true
+}
+
+// Intersecting returns the nodes whose bounds intersect r, in document order.
+func (x *Index) Intersecting(r figmatypes.Rectangle) []Item {
+	q := fromRectangle(r)
+	return x.collect(x.search(q.intersects, q.intersects), false)
+}
+
+// Within returns the nodes whose bounds lie entirely inside r, in document order.
+func (x *Index) Within(r figmatypes.Rectangle) []Item {
+	q := fromRectangle(r)
+	return x.collect(x.search(q.intersects, q.contains), false)
+}
+
+// Overlapping returns the nodes whose bounds intersect those of the node with the given ID,
+// excluding the node itself, its ancestors and its descendants.
+func (x *Index) Overlapping(id string) []Item {
+	i, ok := x.byID[id]
+	if !ok {
+		return nil
+	}
+	n := x.items[i].Node
+	q := x.bounds[i]
+	var idx []int
+	for _, j := range x.search(q.intersects, q.intersects) {
+		if j != i && !contains(x.items[j].Path, n) && !contains(x.items[i].Path, x.items[j].Node) {
+			idx = append(idx, j)
+		}
+	}
+	return x.collect(idx, false)
+}
+
+func contains(path nodes.Path, n nodes.Node) bool {
+	for _, p := range path {
+		if p == n {
+			return true
+		}
+	}
+	return false
+}
+
+// Nearest returns up to k nodes closest to pt, nearest first. Nodes containing pt are at distance zero;
+// ties are broken by document order.
+func (x *Index) Nearest(pt figmatypes.Vector, k int) []Item {
+	if x.tree == nil || k <= 0 {
+		return nil
+	}
+	q := &queue{{dist: x.tree.bounds.distance(pt), node: x.tree, entry: -1}}
+	var out []Item
+	for q.Len() > 0 && len(out) < k {
+		c := heap.Pop(q).(candidate)
+		if c.node == nil {
+			out = append(out, x.items[c.entry])
+			continue
+		}
+		for _, child := range c.node.children {
+			heap.Push(q, candidate{dist: child.bounds.distance(pt), node: child, entry: -1})
+		}
+		for _, e := range c.node.entries {
+			heap.Push(q, candidate{dist: x.bounds[e].distance(pt), entry: e})
+		}
+	}
+	return out
+}
+
+// candidate is a tree node or an entry in the nearest-neighbor queue.
+type candidate struct {
+	dist  float64
+	node  *treeNode
+	entry int
+}
+
+// queue is a min-heap of candidates. Tree nodes sort before entries at the same distance,
+// so that every entry at that distance is considered before any is returned.
+type queue []candidate
+
+func (q queue) Len() int { return len(q) }
+func (q queue) Less(i, j int) bool {
+	if q[i].dist != q[j].dist {
+		return q[i].dist < q[j].dist
+	}
+	if (q[i].node == nil) != (q[j].node == nil) {
+		return q[i].node != nil
+	}
+	return q[i].entry < q[j].entry
+}
+func (q queue) Swap(i, j int)       { q[i], q[j] = q[j], q[i] }
+func (q *queue) Push(x interface{}) { *q = append(*q, x.(candidate)) }
+func (q *queue) Pop() interface{} {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
@@ -0,0 +1,113 @@
+package spatial
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+func testPage(t *testing.T, children string) nodes.Node {
+	t.Helper()
+	var c nodes.Children
+	if err := json.Unmarshal([]byte(`[{"id": "0:1", "type": "CANVAS", "children": [`+children+`]}]`), &c); err != nil {
+		t.Fatal(err)
+	}
+	return c[0]
+}
+
+func node(id, typ string, x, y, w, h float64, extra string) string {
+	return fmt.Sprintf(`{"id": %q, "type": %q, "absoluteBoundingBox": {"x": %v, "y": %v, "width": %v, "height": %v}%s}`,
+		id, typ, x, y, w, h, extra)
+}
+
+func ids(items []Item) string {
+	var s []string
+	for _, it := range items {
+		s = append(s, it.Node.GetID())
+	}
+	return strings.Join(s, " ")
+}
+
+func TestIndex(t *testing.T) {
+	page := testPage(t, strings.Join([]string{
+		node("1:0", "FRAME", 0, 0, 100, 100, `, "clipsContent": true, "children": [`+strings.Join([]string{
+			node("2:0", "RECTANGLE", 10, 10, 20, 20, ""),
+			node("2:1", "RECTANGLE", 90, 90, 20, 20, ""),
+			node("2:2", "RECTANGLE", 200, 200, 10, 10, ""),
+			node("2:3", "RECTANGLE", 10, 10, 20, 20, `, "visible": false`),
+		}, ",")+`]`),
+		node("1:1", "FRAME", 300, 0, 100, 100, `, "children": [`+strings.Join([]string{
+			node("3:0", "TEXT", 310, 10, 50, 10, ""),
+			node("3:1", "TEXT", 340, 15, 50, 10, ""),
+			node("3:2", "TEXT", 350, 150, 50, 10, ""),
+		}, ",")+`]`),
+	}, ","))
+	x := New(page)
+
+	if got, want := x.Len(), 7; got != want {
+		t.Errorf("Len() = %d, want %d", got, want)
+	}
+	if it, ok := x.Item("2:1"); !ok || it.Bounds != (figmatypes.Rectangle{X: 90, Y: 90, Width: 10, Height: 10}) {
+		t.Errorf("Item(2:1) = %+v, %v; want clipped bounds", it, ok)
+	}
+	if _, ok := x.Item("2:2"); ok {
+		t.Error("fully clipped node indexed")
+	}
+
+	tests := []struct {
+		name string
+		got  []Item
+		want string
+	}{
+		{"At", x.At(figmatypes.Vector{X: 15, Y: 15}), "2:0 1:0"},
+		{"At outside clip", x.At(figmatypes.Vector{X: 105, Y: 105}), ""},
+		{"Intersecting", x.Intersecting(figmatypes.Rectangle{X: 50, Y: 85, Width: 300, Height: 10}), "1:0 2:1 1:1"},
+		{"Within", x.Within(figmatypes.Rectangle{X: 300, Y: 0, Width: 100, Height: 100}), "1:1 3:0 3:1"},
+		{"Overlapping", x.Overlapping("3:0"), "3:1"},
+		{"Nearest", x.Nearest(figmatypes.Vector{X: 370, Y: 170}, 2), "3:2 1:1"},
+	}
+	pin, ok := x.Pin(figmatypes.VectorOrFrameOffset{NodeID: "1:1", NodeOffset: &figmatypes.Vector{X: 45, Y: 17}})
+	if !ok || pin != (figmatypes.Vector{X: 345, Y: 17}) {
+		t.Errorf("Pin() = %v, %v", pin, ok)
+	}
+	tests = append(tests, struct {
+		name string
+		got  []Item
+		want string
+	}{"At pin", x.At(pin), "3:1 3:0 1:1"})
+
+	for _, tt := range tests {
+		if got := ids(tt.got); got != tt.want {
+			t.Errorf("%s = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLargeIndex(t *testing.T) {
+	var children []string
+	for i := range 40 {
+		for j := range 40 {
+			children = append(children, node(fmt.Sprintf("%d:%d", i, j), "RECTANGLE", float64(i*10), float64(j*10), 8, 8, ""))
+		}
+	}
+	x := New(testPage(t, strings.Join(children, ",")))
+	if x.Len() != 1600 {
+		t.Fatalf("Len() = %d", x.Len())
+	}
+	if got := ids(x.At(figmatypes.Vector{X: 125, Y: 333})); got != "12:33" {
+		t.Errorf("At = %q", got)
+	}
+	if got := ids(x.At(figmatypes.Vector{X: 129, Y: 333})); got != "" {
+		t.Errorf("At gap = %q", got)
+	}
+	if got := len(x.Intersecting(figmatypes.Rectangle{X: 5, Y: 5, Width: 20, Height: 20})); got != 9 {
+		t.Errorf("Intersecting returned %d items, want 9", got)
+	}
+	if got := ids(x.Nearest(figmatypes.Vector{X: 1000, Y: 1000}, 1)); got != "39:39" {
+		t.Errorf("Nearest = %q", got)
+	}
+}
@@ -0,0 +1,146 @@
+package figma
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// StyleValue is a style of a file together with its value. The REST API only
+// describes styles by name, so values are read from a node that uses the style.
+type StyleValue struct {
+	figmatypes.Style
+	// The ID of the style, the key of the style in File.Styles.
+	ID string
+	// The ID of the node the value was read from.
+	NodeID string
+	// The paints of a FILL style.
+	Paints []figmatypes.Paint
+	// The type style of a TEXT style.
+	Text *figmatypes.TypeStyle
+	// The effects of an EFFECT style.
+	Effects []figmatypes.Effect
+	// The layout grids of a GRID style.
+	LayoutGrids []figmatypes.LayoutGrid
+}
+
+// StyleValues returns the values of the styles of the file, ordered by name and then ID.
+// The value of a style is read from the first node in document order that uses it;
+// styles no node uses, such as unused library styles, are left out.
+// Client.StyleValues also reads the values of unused styles defined in the file.
+func (f *File) StyleValues() []StyleValue {
+	return sortStyleValues(f.styleValues())
+}
+
+// styleValues returns the values of the styles the nodes of f use, by style ID.
+func (f *File) styleValues() map[string]StyleValue {
+	found := make(map[string]StyleValue)
+	for _, n := range nodes.All(&f.Document) {
+		s, ok := n.(nodes.Styled)
+		if !ok {
+			continue
+		}
+		refs := s.GetStyles()
+		kinds := make([]string, 0, len(refs))
+		for k := range refs {
+			kinds = append(kinds, k)
+		}
+		sort.Strings(kinds)
+		for _, kind := range kinds {
+			id := refs[kind]
+			style, ok := f.Styles[id]
+			if _, done := found[id]; done || !ok {
+				continue
+			}
+			v := StyleValue{Style: style, ID: id, NodeID: n.GetID()}
+			if !v.read(n, strings.TrimSuffix(kind, "s")) {
+				continue
+			}
+			found[id] = v
+		}
+	}
+	return found
+}
+
+func sortStyleValues(found map[string]StyleValue) []StyleValue {
+	result := make([]StyleValue, 0, len(found))
+	for _, v := range found {
+		result = append(result, v)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].Name != result[j].Name {
+			return result[i].Name < result[j].Name
+		}
+		return result[i].ID < result[j].ID
+	})
+	return result
+}
+
+// StyleValues returns the values of the styles of f, the file with the given key, like
+// File.StyleValues, but also reads the values of the styles no node of f uses from the
+// nodes that define them. The ID of a local style is the ID of its defining node;
+// styles defined in other files, such as library styles, are still left out.
+func (c *Client) StyleValues(fileKey string, f *File) ([]StyleValue, error) {
+	found := f.styleValues()
+	var ids []string
+	for id := range f.Styles {
+		if _, ok := found[id]; !ok {
+			ids = append(ids, id)
+		}
+	}
+	if len(ids) == 0 {
+		return sortStyleValues(found), nil
+	}
+	sort.Strings(ids)
+	defs, err := c.GetFileNodes(fileKey, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range ids {
+		n, ok := defs[id]
+		if !ok {
+			continue
+		}
+		v := StyleValue{Style: f.Styles[id], ID: id, NodeID: n.GetID()}
+		if v.read(n, strings.ToLower(string(v.StyleType))) {
+			found[id] = v
+		}
+	}
+	return sortStyleValues(found), nil
+}
+
+// read sets the value of v from the property of n the style is applied to as kind,
+// a lower-case style type such as "fill" or "text". It reports whether n had a value.
+func (v *StyleValue) read(n nodes.Node, kind string) bool {
+	p, _ := n.(nodes.Painted)
+	switch {
+	case kind == "fill" && p != nil:
+		v.Paints = p.GetFills()
+	case kind == "stroke" && p != nil:
+		v.Paints = p.GetStrokes()
+	case kind == "effect" && p != nil:
+		v.Effects = p.GetEffects()
+	case kind == "grid":
+		g, ok := n.(nodes.Gridded)
+		if !ok {
+			return false
+		}
+		v.LayoutGrids = g.GetLayoutGrids()
+	case kind == "text":
+		var style figmatypes.TypeStyle
+		switch t := n.(type) {
+		case *nodes.Text:
+			style = t.Style
+		case *nodes.TextPath:
+			style = t.Style
+		default:
+			return false
+		}
+		v.Text = &style
+	default:
+		return false
+	}
+	return true
+}
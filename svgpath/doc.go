@@ -0,0 +1,14 @@
+// Package svgpath parses and manipulates vector path data, such as the
+// fillGeometry and strokeGeometry of vector nodes.
+//
+// Parse accepts the full SVG path grammar and normalizes it to absolute
+// move, line, quadratic, cubic and close commands, the subset Figma
+// emits. Arcs are approximated with cubic curves.
+//
+//	p, err := svgpath.Parse(v.FillGeometry[0].Path)
+//	if err != nil {
+//		return err
+//	}
+//	p = p.Transform(v.GetRelativeTransform())
+//	fmt.Println(p.Bounds(), p.Area(v.FillGeometry[0].WindingRule, 0.1))
+package svgpath
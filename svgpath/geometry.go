@@ -0,0 +1,206 @@
+package svgpath
+
+import (
+	"math"
+	"sort"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Polyline is a flattened subpath.
+type Polyline struct {
+	Points []figmatypes.Vector
+	// Whether the subpath ended with a Close.
+	Closed bool
+}
+
+// maxSteps bounds the number of lines a single curve is flattened into.
+const maxSteps = 1000
+
+// Flatten approximates the path with line segments, one polyline per subpath.
+// Curves are subdivided so that no point of the approximation is further than
+// tolerance from the curve.
+func (p Path) Flatten(tolerance float64) []Polyline {
+	var (
+		out []Polyline
+		cur figmatypes.Vector
+	)
+	for _, s := range p {
+		if s.Op == MoveTo || len(out) == 0 {
+			out = append(out, Polyline{})
+		}
+		pl := &out[len(out)-1]
+		switch s.Op {
+		case MoveTo, LineTo:
+			pl.Points = append(pl.Points, s.Pts[0])
+		case QuadTo:
+			n := steps(0.25*secondDifference(cur, s.Pts[0], s.Pts[1]), tolerance)
+			for i := 1; i <= n; i++ {
+				pl.Points = append(pl.Points, quadAt(cur, s.Pts[0], s.Pts[1], float64(i)/float64(n)))
+			}
+		case CubicTo:
+			m := math.Max(secondDifference(cur, s.Pts[0], s.Pts[1]), secondDifference(s.Pts[0], s.Pts[1], s.Pts[2]))
+			n := steps(0.75*m, tolerance)
+			for i := 1; i <= n; i++ {
+				pl.Points = append(pl.Points, cubicAt(cur, s.Pts[0], s.Pts[1], s.Pts[2], float64(i)/float64(n)))
+			}
+		case Close:
+			pl.Closed = true
+		}
+		cur = endPoint(s, cur)
+	}
+	return out
+}
+
+func secondDifference(a, b, c figmatypes.Vector) float64 {
+	return math.Hypot(a.X-2*b.X+c.X, a.Y-2*b.Y+c.Y)
+}
+
+// steps returns the number of lines needed to flatten a curve within tolerance, using Wang's formula.
+func steps(m, tolerance float64) int {
+	if tolerance <= 0 {
+		return maxSteps
+	}
+	n := int(math.Ceil(math.Sqrt(m / tolerance)))
+	return min(max(n, 1), maxSteps)
+}
+
+// edges returns the edges of the filled outline of the flattened path. Every subpath is implicitly closed.
+func (p Path) edges(tolerance float64) [][2]figmatypes.Vector {
+	var out [][2]figmatypes.Vector
+	for _, pl := range p.Flatten(tolerance) {
+		for i, a := range pl.Points {
+			b := pl.Points[(i+1)%len(pl.Points)]
+			if a != b {
+				out = append(out, [2]figmatypes.Vector{a, b})
+			}
+		}
+	}
+	return out
+}
+
+// Winding returns the winding number of the path around pt: the number of
+// times the outline travels counter-clockwise around it minus the number of
+// times it travels clockwise, in a y-up coordinate system.
+func (p Path) Winding(pt figmatypes.Vector, tolerance float64) int {
+	w := 0
+	for _, e := range p.edges(tolerance) {
+		a, b := e[0], e[1]
+		if a.Y <= pt.Y {
+			if b.Y > pt.Y && cross(a, b, pt) > 0 {
+				w++
+			}
+		} else if b.Y <= pt.Y && cross(a, b, pt) < 0 {
+			w--
+		}
+	}
+	return w
+}
+
+// cross returns the z component of (b-a)×(c-a).
+func cross(a, b, c figmatypes.Vector) float64 {
+	return (b.X-a.X)*(c.Y-a.Y) - (c.X-a.X)*(b.Y-a.Y)
+}
+
+// Contains reports whether pt is inside the region filled by the path under rule.
+// WindingRuleNONE never contains any point.
+func (p Path) Contains(pt figmatypes.Vector, rule figmatypes.WindingRule, tolerance float64) bool {
+	return filled(p.Winding(pt, tolerance), rule)
+}
+
+func filled(winding int, rule figmatypes.WindingRule) bool {
+	switch rule {
+	case figmatypes.WindingRuleEVENODD:
+		return winding%2 != 0
+	case figmatypes.WindingRuleNONE:
+		return false
+	}
+	return winding != 0
+}
+
+// SignedArea returns the sum of the signed areas of the subpaths, positive
+// for counter-clockwise subpaths in a y-up coordinate system. Overlapping
+// regions are counted once per subpath covering them.
+func (p Path) SignedArea(tolerance float64) float64 {
+	var a float64
+	for _, e := range p.edges(tolerance) {
+		a += e[0].X*e[1].Y - e[1].X*e[0].Y
+	}
+	return a / 2
+}
+
+// Area returns the area of the region filled by the path under rule, counting
+// overlapping and self-intersecting regions the way a renderer would.
+func (p Path) Area(rule figmatypes.WindingRule, tolerance float64) float64 {
+	if rule == figmatypes.WindingRuleNONE {
+		return 0
+	}
+	edges := p.edges(tolerance)
+
+	// Between consecutive vertex and intersection heights no two edges cross,
+	// so the filled width varies linearly and the midpoint is exact.
+	var ys []float64
+	for i, e := range edges {
+		ys = append(ys, e[0].Y)
+		for _, f := range edges[i+1:] {
+			if y, ok := intersectY(e, f); ok {
+				ys = append(ys, y)
+			}
+		}
+	}
+	sort.Float64s(ys)
+
+	type crossing struct {
+		x   float64
+		dir int
+	}
+	var (
+		area      float64
+		crossings []crossing
+	)
+	for i := 1; i < len(ys); i++ {
+		h := ys[i] - ys[i-1]
+		if h <= 0 {
+			continue
+		}
+		y := ys[i-1] + h/2
+		crossings = crossings[:0]
+		for _, e := range edges {
+			a, b := e[0], e[1]
+			if (a.Y <= y) == (b.Y <= y) {
+				continue
+			}
+			dir := 1
+			if a.Y > b.Y {
+				dir = -1
+			}
+			crossings = append(crossings, crossing{a.X + (y-a.Y)/(b.Y-a.Y)*(b.X-a.X), dir})
+		}
+		sort.Slice(crossings, func(i, j int) bool { return crossings[i].x < crossings[j].x })
+		w := 0
+		for j, c := range crossings {
+			w += c.dir
+			if j+1 < len(crossings) && filled(w, rule) {
+				area += (crossings[j+1].x - c.x) * h
+			}
+		}
+	}
+	return area
+}
+
+// intersectY returns the height at which two edges properly intersect.
+func intersectY(e, f [2]figmatypes.Vector) (float64, bool) {
+	r := figmatypes.Vector{X: e[1].X - e[0].X, Y: e[1].Y - e[0].Y}
+	s := figmatypes.Vector{X: f[1].X - f[0].X, Y: f[1].Y - f[0].Y}
+	den := r.X*s.Y - r.Y*s.X
+	if den == 0 {
+		return 0, false
+	}
+	qp := figmatypes.Vector{X: f[0].X - e[0].X, Y: f[0].Y - e[0].Y}
+	t := (qp.X*s.Y - qp.Y*s.X) / den
+	u := (qp.X*r.Y - qp.Y*r.X) / den
+	if t <= 0 || t >= 1 || u <= 0 || u >= 1 {
+		return 0, false
+	}
+	return e[0].Y + t*r.Y, true
+}
@@ -0,0 +1,390 @@
+package svgpath
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// SyntaxError is returned by Parse for malformed path data.
+type SyntaxError struct {
+	Data   string
+	Offset int
+	Msg    string
+}
+
+func (e *SyntaxError) Error() string {
+	return fmt.Sprintf("svgpath: %s at offset %d", e.Msg, e.Offset)
+}
+
+// Parse parses SVG path data. Relative commands, shorthand commands (H, V, S, T)
+// and arcs are converted to absolute M, L, Q, C and Z segments.
+func Parse(d string) (Path, error) {
+	p := &parser{s: d}
+	if err := p.parse(); err != nil {
+		return nil, err
+	}
+	return p.path, nil
+}
+
+// MustParse is like Parse but panics if the data is malformed.
+func MustParse(d string) Path {
+	p, err := Parse(d)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+type parser struct {
+	s   string
+	pos int
+
+	path Path
+	// The current point, the start of the current subpath and the last control point.
+	cur, start, ctrl figmatypes.Vector
+	// The previous command, used for the reflection of control points by S and T.
+	prev byte
+	// Whether the last segment was a Close with no MoveTo after it.
+	closed bool
+}
+
+func (p *parser) errorf(format string, args ...interface{}) error {
+	return &SyntaxError{Data: p.s, Offset: p.pos, Msg: fmt.Sprintf(format, args...)}
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case ' ', '\t', '\n', '\r', '\f':
+			p.pos++
+		default:
+			return
+		}
+	}
+}
+
+// skipSeparator skips whitespace and at most one comma.
+func (p *parser) skipSeparator() {
+	p.skipSpace()
+	if p.pos < len(p.s) && p.s[p.pos] == ',' {
+		p.pos++
+		p.skipSpace()
+	}
+}
+
+// atNumber reports whether a number starts at the current position.
+func (p *parser) atNumber() bool {
+	if p.pos >= len(p.s) {
+		return false
+	}
+	c := p.s[p.pos]
+	return c == '-' || c == '+' || c == '.' || (c >= '0' && c <= '9')
+}
+
+func (p *parser) number() (float64, error) {
+	p.skipSeparator()
+	start := p.pos
+	i := p.pos
+	if i < len(p.s) && (p.s[i] == '-' || p.s[i] == '+') {
+		i++
+	}
+	digits := 0
+	for ; i < len(p.s) && p.s[i] >= '0' && p.s[i] <= '9'; i++ {
+		digits++
+	}
+	if i < len(p.s) && p.s[i] == '.' {
+		i++
+		for ; i < len(p.s) && p.s[i] >= '0' && p.s[i] <= '9'; i++ {
+			digits++
+		}
+	}
+	if digits == 0 {
+		return 0, p.errorf("expected number")
+	}
+	if i < len(p.s) && (p.s[i] == 'e' || p.s[i] == 'E') {
+		j := i + 1
+		if j < len(p.s) && (p.s[j] == '-' || p.s[j] == '+') {
+			j++
+		}
+		if j < len(p.s) && p.s[j] >= '0' && p.s[j] <= '9' {
+			for j < len(p.s) && p.s[j] >= '0' && p.s[j] <= '9' {
+				j++
+			}
+			i = j
+		}
+	}
+	v, err := strconv.ParseFloat(p.s[start:i], 64)
+	if err != nil {
+		return 0, p.errorf("invalid number %q", p.s[start:i])
+	}
+	p.pos = i
+	return v, nil
+}
+
+// flag reads an arc flag, which may be written without a following separator.
+func (p *parser) flag() (bool, error) {
+	p.skipSeparator()
+	if p.pos < len(p.s) {
+		switch p.s[p.pos] {
+		case '0':
+			p.pos++
+			return false, nil
+		case '1':
+			p.pos++
+			return true, nil
+		}
+	}
+	return false, p.errorf("expected flag")
+}
+
+func (p *parser) numbers(n int) ([]float64, error) {
+	args := make([]float64, n)
+	for i := range args {
+		v, err := p.number()
+		if err != nil {
+			return nil, err
+		}
+		args[i] = v
+	}
+	return args, nil
+}
+
+func (p *parser) parse() error {
+	p.skipSpace()
+	var cmd byte
+	for {
+		p.skipSpace()
+		if p.pos >= len(p.s) {
+			return nil
+		}
+		c := p.s[p.pos]
+		switch {
+		case isCommand(c):
+			cmd = c
+			p.pos++
+		case p.atNumber() && cmd != 0 && cmd != 'Z' && cmd != 'z':
+			// Implicit repetition of the previous command.
+		default:
+			if cmd == 0 && p.atNumber() {
+				return p.errorf("path data must start with a move command")
+			}
+			return p.errorf("unexpected character %q", c)
+		}
+		if len(p.path) == 0 && cmd != 'M' && cmd != 'm' {
+			return p.errorf("path data must start with a move command")
+		}
+		if err := p.command(cmd); err != nil {
+			return err
+		}
+		// Coordinates following a move are implicit lines.
+		switch cmd {
+		case 'M':
+			cmd = 'L'
+		case 'm':
+			cmd = 'l'
+		}
+		p.skipSeparator()
+	}
+}
+
+func isCommand(c byte) bool {
+	switch c {
+	case 'M', 'm', 'L', 'l', 'H', 'h', 'V', 'v', 'C', 'c', 'S', 's', 'Q', 'q', 'T', 't', 'A', 'a', 'Z', 'z':
+		return true
+	}
+	return false
+}
+
+func (p *parser) command(cmd byte) error {
+	rel := cmd >= 'a'
+	upper := cmd &^ 0x20
+	var ox, oy float64
+	if rel {
+		ox, oy = p.cur.X, p.cur.Y
+	}
+	pt := func(x, y float64) figmatypes.Vector { return figmatypes.Vector{X: ox + x, Y: oy + y} }
+
+	if upper != 'M' && upper != 'Z' && p.closed {
+		// A drawing command after a close starts a new subpath at the previous start point.
+		p.emit(Segment{Op: MoveTo, Pts: [3]figmatypes.Vector{p.start}})
+	}
+
+	switch upper {
+	case 'M':
+		a, err := p.numbers(2)
+		if err != nil {
+			return err
+		}
+		p.start = pt(a[0], a[1])
+		p.emit(Segment{Op: MoveTo, Pts: [3]figmatypes.Vector{p.start}})
+	case 'Z':
+		p.emit(Segment{Op: Close})
+		p.cur = p.start
+		p.closed = true
+	case 'L':
+		a, err := p.numbers(2)
+		if err != nil {
+			return err
+		}
+		p.line(pt(a[0], a[1]))
+	case 'H':
+		a, err := p.numbers(1)
+		if err != nil {
+			return err
+		}
+		p.line(figmatypes.Vector{X: ox + a[0], Y: p.cur.Y})
+	case 'V':
+		a, err := p.numbers(1)
+		if err != nil {
+			return err
+		}
+		p.line(figmatypes.Vector{X: p.cur.X, Y: oy + a[0]})
+	case 'C':
+		a, err := p.numbers(6)
+		if err != nil {
+			return err
+		}
+		p.cubic(pt(a[0], a[1]), pt(a[2], a[3]), pt(a[4], a[5]))
+	case 'S':
+		a, err := p.numbers(4)
+		if err != nil {
+			return err
+		}
+		c1 := p.cur
+		if p.prev == 'C' || p.prev == 'S' {
+			c1 = reflect(p.ctrl, p.cur)
+		}
+		p.cubic(c1, pt(a[0], a[1]), pt(a[2], a[3]))
+	case 'Q':
+		a, err := p.numbers(4)
+		if err != nil {
+			return err
+		}
+		p.quad(pt(a[0], a[1]), pt(a[2], a[3]))
+	case 'T':
+		a, err := p.numbers(2)
+		if err != nil {
+			return err
+		}
+		c := p.cur
+		if p.prev == 'Q' || p.prev == 'T' {
+			c = reflect(p.ctrl, p.cur)
+		}
+		p.quad(c, pt(a[0], a[1]))
+	case 'A':
+		a, err := p.numbers(3)
+		if err != nil {
+			return err
+		}
+		large, err := p.flag()
+		if err != nil {
+			return err
+		}
+		sweep, err := p.flag()
+		if err != nil {
+			return err
+		}
+		end, err := p.numbers(2)
+		if err != nil {
+			return err
+		}
+		p.arc(a[0], a[1], a[2]*math.Pi/180, large, sweep, pt(end[0], end[1]))
+	}
+	p.prev = upper
+	return nil
+}
+
+func (p *parser) emit(s Segment) {
+	p.path = append(p.path, s)
+	p.closed = s.Op == Close
+	if pts := s.Points(); len(pts) > 0 {
+		p.cur = pts[len(pts)-1]
+	}
+}
+
+func (p *parser) line(to figmatypes.Vector) {
+	p.emit(Segment{Op: LineTo, Pts: [3]figmatypes.Vector{to}})
+}
+
+func (p *parser) quad(c, to figmatypes.Vector) {
+	p.emit(Segment{Op: QuadTo, Pts: [3]figmatypes.Vector{c, to}})
+	p.ctrl = c
+}
+
+func (p *parser) cubic(c1, c2, to figmatypes.Vector) {
+	p.emit(Segment{Op: CubicTo, Pts: [3]figmatypes.Vector{c1, c2, to}})
+	p.ctrl = c2
+}
+
+func reflect(c, about figmatypes.Vector) figmatypes.Vector {
+	return figmatypes.Vector{X: 2*about.X - c.X, Y: 2*about.Y - c.Y}
+}
+
+// arc appends cubic curves approximating an elliptical arc, following the
+// endpoint to center conversion in the SVG specification, appendix B.2.4.
+func (p *parser) arc(rx, ry, phi float64, large, sweep bool, to figmatypes.Vector) {
+	from := p.cur
+	if from == to {
+		return
+	}
+	rx, ry = math.Abs(rx), math.Abs(ry)
+	if rx == 0 || ry == 0 {
+		p.line(to)
+		return
+	}
+	sin, cos := math.Sincos(phi)
+	dx, dy := (from.X-to.X)/2, (from.Y-to.Y)/2
+	x1 := cos*dx + sin*dy
+	y1 := -sin*dx + cos*dy
+
+	// Scale up radii that are too small to reach the end point.
+	if l := x1*x1/(rx*rx) + y1*y1/(ry*ry); l > 1 {
+		s := math.Sqrt(l)
+		rx, ry = rx*s, ry*s
+	}
+
+	num := rx*rx*ry*ry - rx*rx*y1*y1 - ry*ry*x1*x1
+	den := rx*rx*y1*y1 + ry*ry*x1*x1
+	k := math.Sqrt(math.Max(0, num/den))
+	if large == sweep {
+		k = -k
+	}
+	cx1, cy1 := k*rx*y1/ry, -k*ry*x1/rx
+	cx := cos*cx1 - sin*cy1 + (from.X+to.X)/2
+	cy := sin*cx1 + cos*cy1 + (from.Y+to.Y)/2
+
+	theta := math.Atan2((y1-cy1)/ry, (x1-cx1)/rx)
+	delta := math.Atan2((-y1-cy1)/ry, (-x1-cx1)/rx) - theta
+	if sweep && delta < 0 {
+		delta += 2 * math.Pi
+	} else if !sweep && delta > 0 {
+		delta -= 2 * math.Pi
+	}
+
+	// Split into pieces of at most 90 degrees, each approximated by one cubic.
+	n := int(math.Ceil(math.Abs(delta) / (math.Pi / 2)))
+	step := delta / float64(n)
+	alpha := 4.0 / 3.0 * math.Tan(step/4)
+	point := func(t float64) (figmatypes.Vector, figmatypes.Vector) {
+		st, ct := math.Sincos(t)
+		pos := figmatypes.Vector{X: cx + rx*cos*ct - ry*sin*st, Y: cy + rx*sin*ct + ry*cos*st}
+		deriv := figmatypes.Vector{X: -rx*cos*st - ry*sin*ct, Y: -rx*sin*st + ry*cos*ct}
+		return pos, deriv
+	}
+	p0, d0 := point(theta)
+	for i := 1; i <= n; i++ {
+		p1, d1 := point(theta + step*float64(i))
+		if i == n {
+			p1 = to
+		}
+		p.cubic(
+			figmatypes.Vector{X: p0.X + alpha*d0.X, Y: p0.Y + alpha*d0.Y},
+			figmatypes.Vector{X: p1.X - alpha*d1.X, Y: p1.Y - alpha*d1.Y},
+			p1,
+		)
+		p0, d0 = p1, d1
+	}
+}
@@ -0,0 +1,200 @@
+package svgpath
+
+import (
+	"math"
+	"strconv"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Op is a path command.
+type Op byte
+
+const (
+	MoveTo  Op = 'M'
+	LineTo  Op = 'L'
+	QuadTo  Op = 'Q'
+	CubicTo Op = 'C'
+	Close   Op = 'Z'
+)
+
+// Segment is a single command with absolute coordinates.
+type Segment struct {
+	Op Op
+	// The control points followed by the end point; see Points.
+	Pts [3]figmatypes.Vector
+}
+
+// Points returns the points used by the segment: one for MoveTo and LineTo,
+// two for QuadTo, three for CubicTo and none for Close.
+func (s Segment) Points() []figmatypes.Vector {
+	switch s.Op {
+	case MoveTo, LineTo:
+		return s.Pts[:1]
+	case QuadTo:
+		return s.Pts[:2]
+	case CubicTo:
+		return s.Pts[:3]
+	}
+	return nil
+}
+
+// Path is a sequence of segments. Every subpath starts with MoveTo, including those following a Close.
+type Path []Segment
+
+// String returns the path data with coordinates in their shortest exact form.
+func (p Path) String() string {
+	return p.Format(-1)
+}
+
+// Format returns compact path data with coordinates rounded to prec decimal places.
+// Numbers are separated by a space unless the following number is negative.
+// A negative prec uses the shortest exact representation.
+func (p Path) Format(prec int) string {
+	var b []byte
+	for _, s := range p {
+		b = append(b, byte(s.Op))
+		for i, pt := range s.Points() {
+			b = appendNumber(b, pt.X, prec, i > 0)
+			b = appendNumber(b, pt.Y, prec, true)
+		}
+	}
+	return string(b)
+}
+
+// appendNumber appends v, preceded by a space if it is not the first number after a command and it is not negative.
+func appendNumber(b []byte, v float64, prec int, sep bool) []byte {
+	if prec >= 0 {
+		v = math.Round(v*math.Pow10(prec)) / math.Pow10(prec)
+	}
+	if v == 0 {
+		v = 0 // Normalize -0.
+	}
+	if sep && v >= 0 {
+		b = append(b, ' ')
+	}
+	return strconv.AppendFloat(b, v, 'f', -1, 64)
+}
+
+// Transform returns p with every point transformed by t.
+// Affine transforms map Bézier curves exactly through their control points.
+func (p Path) Transform(t figmatypes.Transform) Path {
+	t = t.OrIdentity()
+	out := make(Path, len(p))
+	for i, s := range p {
+		out[i].Op = s.Op
+		for j := range s.Points() {
+			out[i].Pts[j] = t.Apply(s.Pts[j])
+		}
+	}
+	return out
+}
+
+// Bounds returns the exact bounding box of the path, including curve extrema.
+// It returns the zero rectangle for an empty path.
+func (p Path) Bounds() figmatypes.Rectangle {
+	b := bounds{minX: math.Inf(1), minY: math.Inf(1), maxX: math.Inf(-1), maxY: math.Inf(-1)}
+	var cur figmatypes.Vector
+	for _, s := range p {
+		switch s.Op {
+		case MoveTo, LineTo:
+			b.add(s.Pts[0])
+		case QuadTo:
+			b.add(s.Pts[1])
+			for _, t := range quadExtrema(cur, s.Pts[0], s.Pts[1]) {
+				b.add(quadAt(cur, s.Pts[0], s.Pts[1], t))
+			}
+		case CubicTo:
+			b.add(s.Pts[2])
+			for _, t := range cubicExtrema(cur, s.Pts[0], s.Pts[1], s.Pts[2]) {
+				b.add(cubicAt(cur, s.Pts[0], s.Pts[1], s.Pts[2], t))
+			}
+		}
+		cur = endPoint(s, cur)
+	}
+	if math.IsInf(b.minX, 1) {
+		return figmatypes.Rectangle{}
+	}
+	return figmatypes.Rectangle{X: b.minX, Y: b.minY, Width: b.maxX - b.minX, Height: b.maxY - b.minY}
+}
+
+type bounds struct {
+	minX, minY, maxX, maxY float64
+}
+
+func (b *bounds) add(v figmatypes.Vector) {
+	b.minX, b.maxX = math.Min(b.minX, v.X), math.Max(b.maxX, v.X)
+	b.minY, b.maxY = math.Min(b.minY, v.Y), math.Max(b.maxY, v.Y)
+}
+
+// endPoint returns the current point after s.
+// Close leaves it unchanged, as a Close is always followed by a MoveTo.
+func endPoint(s Segment, cur figmatypes.Vector) figmatypes.Vector {
+	if pts := s.Points(); len(pts) > 0 {
+		return pts[len(pts)-1]
+	}
+	return cur
+}
+
+func quadAt(p0, p1, p2 figmatypes.Vector, t float64) figmatypes.Vector {
+	mt := 1 - t
+	return figmatypes.Vector{
+		X: mt*mt*p0.X + 2*mt*t*p1.X + t*t*p2.X,
+		Y: mt*mt*p0.Y + 2*mt*t*p1.Y + t*t*p2.Y,
+	}
+}
+
+func cubicAt(p0, p1, p2, p3 figmatypes.Vector, t float64) figmatypes.Vector {
+	mt := 1 - t
+	a, b, c, d := mt*mt*mt, 3*mt*mt*t, 3*mt*t*t, t*t*t
+	return figmatypes.Vector{
+		X: a*p0.X + b*p1.X + c*p2.X + d*p3.X,
+		Y: a*p0.Y + b*p1.Y + c*p2.Y + d*p3.Y,
+	}
+}
+
+// quadExtrema returns the parameters in (0, 1) where the derivative of either coordinate is zero.
+func quadExtrema(p0, p1, p2 figmatypes.Vector) []float64 {
+	var ts []float64
+	for _, c := range [][3]float64{{p0.X, p1.X, p2.X}, {p0.Y, p1.Y, p2.Y}} {
+		if d := c[0] - 2*c[1] + c[2]; d != 0 {
+			if t := (c[0] - c[1]) / d; t > 0 && t < 1 {
+				ts = append(ts, t)
+			}
+		}
+	}
+	return ts
+}
+
+// cubicExtrema returns the parameters in (0, 1) where the derivative of either coordinate is zero.
+func cubicExtrema(p0, p1, p2, p3 figmatypes.Vector) []float64 {
+	var ts []float64
+	for _, c := range [][4]float64{{p0.X, p1.X, p2.X, p3.X}, {p0.Y, p1.Y, p2.Y, p3.Y}} {
+		a := -c[0] + 3*c[1] - 3*c[2] + c[3]
+		b := 2 * (c[0] - 2*c[1] + c[2])
+		k := c[1] - c[0]
+		for _, t := range solveQuadratic(a, b, k) {
+			if t > 0 && t < 1 {
+				ts = append(ts, t)
+			}
+		}
+	}
+	return ts
+}
+
+// solveQuadratic returns the real roots of a·t² + b·t + c.
+func solveQuadratic(a, b, c float64) []float64 {
+	const eps = 1e-12
+	if math.Abs(a) < eps {
+		if math.Abs(b) < eps {
+			return nil
+		}
+		return []float64{-c / b}
+	}
+	d := b*b - 4*a*c
+	if d < 0 {
+		return nil
+	}
+	sq := math.Sqrt(d)
+	return []float64{(-b + sq) / (2 * a), (-b - sq) / (2 * a)}
+}
@@ -0,0 +1,121 @@
+package svgpath
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		in, want string
+	}{
+		{"M0 0L10 0L10 10Z", "M0 0L10 0L10 10Z"},
+		{"m 1,2 3,4 h 5 v -6 z", "M1 2L4 6L9 6L9 0Z"},
+		{"M0 0C1 2 3 4 5 6S9 10 11 12", "M0 0C1 2 3 4 5 6C7 8 9 10 11 12"},
+		{"M0 0Q5 5 10 0T20 0", "M0 0Q5 5 10 0Q15-5 20 0"},
+		{"M.5.5-1-1e1", "M0.5 0.5L-1-10"},
+		{"M0 0L10 10ZL5 0", "M0 0L10 10ZM0 0L5 0"},
+		{"M0 0A5 5 0 0 1 10 0", ""},
+	}
+	for _, tt := range tests {
+		p, err := Parse(tt.in)
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.in, err)
+			continue
+		}
+		if tt.want != "" && p.String() != tt.want {
+			t.Errorf("Parse(%q) = %q, want %q", tt.in, p.String(), tt.want)
+		}
+	}
+
+	for _, in := range []string{"L0 0", "M0", "M0 0 X", "M0 0A1 1 0 2 0 1 1", "10 10"} {
+		_, err := Parse(in)
+		var serr *SyntaxError
+		if !errors.As(err, &serr) {
+			t.Errorf("Parse(%q): got %v, want *SyntaxError", in, err)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	p := MustParse("M0.123456 -0.0001L10 10").Transform(figmatypes.ScaleTransform(1, 1))
+	if got, want := p.Format(2), "M0.12 0L10 10"; got != want {
+		t.Errorf("Format(2) = %q, want %q", got, want)
+	}
+}
+
+func approx(a, b float64) bool { return math.Abs(a-b) < 1e-6 }
+
+func TestBounds(t *testing.T) {
+	// The curve bulges above and to the right of its end points.
+	p := MustParse("M0 0C0 -10 10 -10 10 0")
+	b := p.Bounds()
+	if !approx(b.X, 0) || !approx(b.Y, -7.5) || !approx(b.Width, 10) || !approx(b.Height, 7.5) {
+		t.Errorf("Bounds() = %+v", b)
+	}
+
+	moved := p.Transform(figmatypes.TranslateTransform(5, 5)).Bounds()
+	if !approx(moved.X, 5) || !approx(moved.Y, -2.5) {
+		t.Errorf("transformed Bounds() = %+v", moved)
+	}
+}
+
+func TestArea(t *testing.T) {
+	square := "M0 0L10 0L10 10L0 10Z"
+	hole := "M2 2L8 2L8 8L2 8Z"
+	reversedHole := "M2 2L2 8L8 8L8 2Z"
+	tests := []struct {
+		name string
+		d    string
+		rule figmatypes.WindingRule
+		want float64
+	}{
+		{"square", square, figmatypes.WindingRuleNONZERO, 100},
+		{"same direction nonzero", square + hole, figmatypes.WindingRuleNONZERO, 100},
+		{"same direction evenodd", square + hole, figmatypes.WindingRuleEVENODD, 64},
+		{"reversed hole nonzero", square + reversedHole, figmatypes.WindingRuleNONZERO, 64},
+		{"bowtie", "M0 0L10 10L10 0L0 10Z", figmatypes.WindingRuleNONZERO, 50},
+		{"none", square, figmatypes.WindingRuleNONE, 0},
+	}
+	for _, tt := range tests {
+		got := MustParse(tt.d).Area(tt.rule, 0.01)
+		if !approx(got, tt.want) {
+			t.Errorf("%s: Area() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	circle := MustParse("M0 -10A10 10 0 0 1 0 10A10 10 0 0 1 0 -10Z")
+	if got := circle.Area(figmatypes.WindingRuleNONZERO, 0.001); math.Abs(got-100*math.Pi) > 0.1 {
+		t.Errorf("circle Area() = %v, want %v", got, 100*math.Pi)
+	}
+	if got := circle.SignedArea(0.001); math.Abs(math.Abs(got)-100*math.Pi) > 0.1 {
+		t.Errorf("circle SignedArea() = %v", got)
+	}
+
+	p := MustParse(square + hole)
+	if !p.Contains(figmatypes.Vector{X: 5, Y: 5}, figmatypes.WindingRuleNONZERO, 0.1) {
+		t.Error("nonzero: center not contained")
+	}
+	if p.Contains(figmatypes.Vector{X: 5, Y: 5}, figmatypes.WindingRuleEVENODD, 0.1) {
+		t.Error("evenodd: center contained")
+	}
+}
+
+func TestFlatten(t *testing.T) {
+	p := MustParse("M0 0Q5 10 10 0ZM20 20L30 20")
+	lines := p.Flatten(0.1)
+	if len(lines) != 2 || !lines[0].Closed || lines[1].Closed {
+		t.Fatalf("Flatten() = %+v", lines)
+	}
+	if n := len(lines[0].Points); n < 5 {
+		t.Errorf("curve flattened into %d points", n)
+	}
+	for _, pt := range lines[0].Points {
+		if pt.Y < 0 || pt.Y > 5 {
+			t.Errorf("point %v off the curve", pt)
+		}
+	}
+}
@@ -0,0 +1,18 @@
+// Package tokens exports the styles and variables of a file as design tokens
+// in the format of the W3C Design Tokens Community Group.
+//
+// Fill, text, effect and grid styles and, when given, local variables become
+// tokens grouped by the slash-separated parts of their names; variables are
+// grouped under the name of their collection first. Variables that alias
+// other variables, and paints bound to variables, are written as references
+// such as "{Primitives.blue.500}". Groups and tokens are written in name order
+// so that exports of the same file are byte-for-byte identical.
+//
+//	group, err := tokens.Export(file, vars)
+//	...
+//	data, err := json.MarshalIndent(group, "", "  ")
+//
+// Styles are read from the nodes that use them, so unused styles are missing
+// unless their values are fetched with Client.StyleValues and given to Export
+// with the Styles option.
+package tokens
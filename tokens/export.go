@@ -0,0 +1,379 @@
+package tokens
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Extension is the key of the $extensions entry Export adds to every token.
+// It holds the ID of the style or variable the token comes from and, for
+// variables with several modes, the value in each mode keyed by mode name.
+const Extension = "com.figma"
+
+// Option configures Export.
+type Option func(*options)
+
+type options struct {
+	// Modes chosen with Mode, by collection ID or name.
+	modes map[string]string
+	// Style values given with Styles, or nil to use File.StyleValues.
+	styles []figma.StyleValue
+}
+
+// Mode chooses the mode of a variable collection whose values become the values
+// of its tokens. The collection and mode may be given by ID or by name.
+// By default the values of the default mode are used.
+func Mode(collection, mode string) Option {
+	return func(o *options) {
+		o.modes[collection] = mode
+	}
+}
+
+// Styles sets the style values to export instead of those returned by
+// File.StyleValues. Use it with the values returned by Client.StyleValues to
+// export styles that no node of the file uses.
+func Styles(values []figma.StyleValue) Option {
+	return func(o *options) {
+		o.styles = values
+	}
+}
+
+type exporter struct {
+	options
+	vars *figma.LocalVariables
+	// Token paths of the variables, by ID.
+	paths map[string][]string
+}
+
+// Export returns the tokens for the styles of f and, if vars is not nil, for
+// its variables. Styles are exported from the values the file's nodes use,
+// as returned by File.StyleValues, unless other values are given with Styles;
+// styles whose value has no token type, such as image fills or blurs, are
+// left out.
+//
+// Names are split on "/" into groups, as by Path.
+func Export(f *figma.File, vars *figma.LocalVariables, opts ...Option) (*Group, error) {
+	e := &exporter{
+		options: options{modes: make(map[string]string)},
+		vars:    vars,
+		paths:   make(map[string][]string),
+	}
+	for _, opt := range opts {
+		opt(&e.options)
+	}
+	g := new(Group)
+	if vars != nil {
+		if err := e.variables(g); err != nil {
+			return nil, err
+		}
+	}
+	styles := e.styles
+	if styles == nil {
+		styles = f.StyleValues()
+	}
+	for _, v := range styles {
+		if err := e.style(g, v); err != nil {
+			return nil, err
+		}
+	}
+	return g, nil
+}
+
+// Path splits a style or variable name into the names of its groups and token.
+// Characters not allowed in token names, ".", "{" and "}", are replaced by "_",
+// as is a leading "$", which marks the properties of tokens and groups.
+func Path(name string) []string {
+	var path []string
+	for _, part := range strings.Split(name, "/") {
+		part = strings.Map(func(r rune) rune {
+			switch r {
+			case '.', '{', '}':
+				return '_'
+			}
+			return r
+		}, strings.TrimSpace(part))
+		if strings.HasPrefix(part, "$") {
+			part = "_" + part[1:]
+		}
+		if part != "" {
+			path = append(path, part)
+		}
+	}
+	return path
+}
+
+func (e *exporter) style(g *Group, v figma.StyleValue) error {
+	path := Path(v.Name)
+	ext := map[string]interface{}{Extension: map[string]interface{}{"styleId": v.ID}}
+	var t *Token
+	switch v.StyleType {
+	case figmatypes.StyleTypeFILL:
+		t = e.paint(v.Paints)
+	case figmatypes.StyleTypeTEXT:
+		if v.Text == nil {
+			return nil
+		}
+		t = typography(*v.Text)
+	case figmatypes.StyleTypeEFFECT:
+		t = shadows(v.Effects)
+	case figmatypes.StyleTypeGRID:
+		return grids(g, path, v, ext)
+	}
+	if t == nil {
+		return nil
+	}
+	t.Description = v.Description
+	t.Extensions = ext
+	return g.Add(path, t)
+}
+
+// paint returns the token for the paints of a fill style, which must have a
+// single visible solid or gradient paint.
+func (e *exporter) paint(paints []figmatypes.Paint) *Token {
+	var shown []figmatypes.Paint
+	for _, p := range paints {
+		if p.IsVisible() {
+			shown = append(shown, p)
+		}
+	}
+	if len(shown) != 1 {
+		return nil
+	}
+	p := shown[0]
+	switch p.Type {
+	case figmatypes.PaintTypeSOLID:
+		if p.Color == nil {
+			return nil
+		}
+		return &Token{Type: TypeColor, Value: e.color(*p.Color, p.GetOpacity(), p.BoundVariables)}
+	case figmatypes.PaintTypeGRADIENT_LINEAR, figmatypes.PaintTypeGRADIENT_RADIAL,
+		figmatypes.PaintTypeGRADIENT_ANGULAR, figmatypes.PaintTypeGRADIENT_DIAMOND:
+		stops := make([]GradientStop, len(p.GradientStops))
+		for i, s := range p.GradientStops {
+			stops[i] = GradientStop{Color: e.color(s.Color, p.GetOpacity(), s.BoundVariables), Position: round(s.Position)}
+		}
+		return &Token{Type: TypeGradient, Value: stops}
+	}
+	return nil
+}
+
+// color returns a reference to the variable bound to the color, if it was exported,
+// and the color otherwise. A reference drops the paint's opacity, as the format
+// cannot express it.
+func (e *exporter) color(c figmatypes.Color, opacity float64, bound map[string]figmatypes.VariableAlias) interface{} {
+	if a, ok := bound["color"]; ok {
+		if path, ok := e.paths[a.ID]; ok {
+			return Reference(path)
+		}
+	}
+	return NewColor(c, opacity)
+}
+
+func typography(s figmatypes.TypeStyle) *Token {
+	t := Typography{
+		FontFamily:    s.FontFamily,
+		FontSize:      Px(s.FontSize),
+		FontWeight:    s.FontWeight,
+		LetterSpacing: Px(s.LetterSpacing),
+	}
+	if s.FontSize > 0 && s.LineHeightPx > 0 {
+		t.LineHeight = round(s.LineHeightPx / s.FontSize)
+	}
+	return &Token{Type: TypeTypography, Value: t}
+}
+
+func shadows(effects []figmatypes.Effect) *Token {
+	var result []Shadow
+	for _, fx := range effects {
+		if !fx.IsVisible() {
+			continue
+		}
+		switch fx.Type {
+		case figmatypes.EffectTypeDROP_SHADOW, figmatypes.EffectTypeINNER_SHADOW:
+			result = append(result, Shadow{
+				Color:   NewColor(fx.Color, 1),
+				OffsetX: Px(fx.Offset.X),
+				OffsetY: Px(fx.Offset.Y),
+				Blur:    Px(fx.Radius),
+				Spread:  Px(fx.Spread),
+				Inset:   fx.Type == figmatypes.EffectTypeINNER_SHADOW,
+			})
+		}
+	}
+	switch len(result) {
+	case 0:
+		return nil
+	case 1:
+		return &Token{Type: TypeShadow, Value: result[0]}
+	}
+	return &Token{Type: TypeShadow, Value: result}
+}
+
+// grids adds a group for each layout grid of a grid style, named after its
+// pattern, holding its count and sizes.
+func grids(g *Group, path []string, v figma.StyleValue, ext map[string]interface{}) error {
+	seen := make(map[string]int)
+	for _, grid := range v.LayoutGrids {
+		if !grid.IsVisible() {
+			continue
+		}
+		name := strings.ToLower(string(grid.Pattern))
+		if seen[name]++; seen[name] > 1 {
+			name = fmt.Sprintf("%s%d", name, seen[name])
+		}
+		add := func(field string, t *Token) error {
+			t.Description = v.Description
+			t.Extensions = ext
+			return g.Add(append(path[:len(path):len(path)], name, field), t)
+		}
+		if grid.Count > 0 {
+			if err := add("count", &Token{Type: TypeNumber, Value: float64(grid.Count)}); err != nil {
+				return err
+			}
+		}
+		if grid.SectionSize > 0 {
+			if err := add("sectionSize", &Token{Type: TypeDimension, Value: Px(grid.SectionSize)}); err != nil {
+				return err
+			}
+		}
+		if err := add("gutterSize", &Token{Type: TypeDimension, Value: Px(grid.GutterSize)}); err != nil {
+			return err
+		}
+		if err := add("offset", &Token{Type: TypeDimension, Value: Px(grid.Offset)}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *exporter) variables(g *Group) error {
+	ids := make([]string, 0, len(e.vars.Variables))
+	for id, v := range e.vars.Variables {
+		if v.DeletedButReferenced {
+			continue
+		}
+		c, ok := e.vars.VariableCollections[v.VariableCollectionID]
+		if !ok {
+			return fmt.Errorf("tokens: variable %q is in unknown collection %s", v.Name, v.VariableCollectionID)
+		}
+		e.paths[id] = append(Path(c.Name), Path(v.Name)...)
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	for _, id := range ids {
+		v := e.vars.Variables[id]
+		c := e.vars.VariableCollections[v.VariableCollectionID]
+		mode, err := e.mode(c)
+		if err != nil {
+			return err
+		}
+		typ := variableType(v)
+		value, err := e.variableValue(v, typ, v.ValuesByMode[mode])
+		if err != nil {
+			return err
+		}
+		ext := map[string]interface{}{"variableId": id}
+		if len(c.Modes) > 1 {
+			modes := make(map[string]interface{}, len(c.Modes))
+			for _, m := range c.Modes {
+				if modes[m.Name], err = e.variableValue(v, typ, v.ValuesByMode[m.ModeID]); err != nil {
+					return err
+				}
+			}
+			ext["modes"] = modes
+		}
+		t := &Token{
+			Type:        typ,
+			Value:       value,
+			Description: v.Description,
+			Extensions:  map[string]interface{}{Extension: ext},
+		}
+		if err := g.Add(e.paths[id], t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// mode returns the ID of the mode of c used for token values.
+func (e *exporter) mode(c figmatypes.VariableCollection) (string, error) {
+	want, ok := e.modes[c.ID]
+	if !ok {
+		if want, ok = e.modes[c.Name]; !ok {
+			return c.DefaultModeID, nil
+		}
+	}
+	m, ok := c.Mode(want)
+	if !ok {
+		return "", fmt.Errorf("tokens: collection %q has no mode %q", c.Name, want)
+	}
+	return m.ModeID, nil
+}
+
+// dimensionScopes are the variable scopes of lengths in pixels.
+var dimensionScopes = map[string]bool{
+	"CORNER_RADIUS":     true,
+	"WIDTH_HEIGHT":      true,
+	"GAP":               true,
+	"STROKE_FLOAT":      true,
+	"EFFECT_FLOAT":      true,
+	"FONT_SIZE":         true,
+	"LETTER_SPACING":    true,
+	"PARAGRAPH_SPACING": true,
+	"PARAGRAPH_INDENT":  true,
+}
+
+// variableType returns the token type of a variable, using its scopes to tell
+// dimensions, font weights and font families from other numbers and strings.
+func variableType(v figmatypes.Variable) string {
+	switch v.ResolvedType {
+	case figmatypes.VariableResolvedTypeCOLOR:
+		return TypeColor
+	case figmatypes.VariableResolvedTypeBOOLEAN:
+		return TypeBoolean
+	case figmatypes.VariableResolvedTypeSTRING:
+		if len(v.Scopes) > 0 && allScopes(v.Scopes, func(s string) bool { return s == "FONT_FAMILY" }) {
+			return TypeFontFamily
+		}
+		return TypeString
+	}
+	switch {
+	case len(v.Scopes) == 0:
+	case allScopes(v.Scopes, func(s string) bool { return s == "FONT_WEIGHT" }):
+		return TypeFontWeight
+	case allScopes(v.Scopes, func(s string) bool { return dimensionScopes[s] }):
+		return TypeDimension
+	}
+	return TypeNumber
+}
+
+func allScopes(scopes []string, match func(string) bool) bool {
+	for _, s := range scopes {
+		if !match(s) {
+			return false
+		}
+	}
+	return true
+}
+
+func (e *exporter) variableValue(v figmatypes.Variable, typ string, value figmatypes.VariableValue) (interface{}, error) {
+	switch {
+	case value.Alias != nil:
+		path, ok := e.paths[value.Alias.ID]
+		if !ok {
+			return nil, fmt.Errorf("tokens: variable %q aliases unknown variable %s", v.Name, value.Alias.ID)
+		}
+		return Reference(path), nil
+	case value.Color != nil:
+		return NewColor(*value.Color, 1), nil
+	case value.Float != nil && typ == TypeDimension:
+		return Px(*value.Float), nil
+	case value.IsZero():
+		return nil, fmt.Errorf("tokens: variable %q has no value in the exported mode", v.Name)
+	}
+	return value.Interface(), nil
+}
@@ -0,0 +1,194 @@
+package tokens
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/tmc/figma/figmatypes"
+)
+
+// Token types used by Export. String and boolean are not defined by the
+// format but are widely understood by token tools.
+const (
+	TypeColor      = "color"
+	TypeDimension  = "dimension"
+	TypeNumber     = "number"
+	TypeFontFamily = "fontFamily"
+	TypeFontWeight = "fontWeight"
+	TypeTypography = "typography"
+	TypeShadow     = "shadow"
+	TypeGradient   = "gradient"
+	TypeString     = "string"
+	TypeBoolean    = "boolean"
+)
+
+// Token is a design token.
+type Token struct {
+	Type string `json:"$type,omitempty"`
+	// The value: a Color, Dimension, Typography, Shadow, []Shadow, []GradientStop,
+	// float64, string or bool, or a reference to another token.
+	Value       interface{}            `json:"$value"`
+	Description string                 `json:"$description,omitempty"`
+	Extensions  map[string]interface{} `json:"$extensions,omitempty"`
+}
+
+// Group is a group of tokens and nested groups, keyed by name.
+type Group struct {
+	Tokens map[string]*Token
+	Groups map[string]*Group
+}
+
+// Add adds a token at path, creating the groups leading to it.
+// It fails if path already holds a token or a group.
+func (g *Group) Add(path []string, t *Token) error {
+	if len(path) == 0 {
+		return fmt.Errorf("tokens: empty token path")
+	}
+	for i, name := range path[:len(path)-1] {
+		if _, ok := g.Tokens[name]; ok {
+			return fmt.Errorf("tokens: %q is both a token and a group", strings.Join(path[:i+1], "."))
+		}
+		next, ok := g.Groups[name]
+		if !ok {
+			if g.Groups == nil {
+				g.Groups = make(map[string]*Group)
+			}
+			next = new(Group)
+			g.Groups[name] = next
+		}
+		g = next
+	}
+	name := path[len(path)-1]
+	if _, ok := g.Tokens[name]; ok {
+		return fmt.Errorf("tokens: %q is defined more than once", strings.Join(path, "."))
+	}
+	if _, ok := g.Groups[name]; ok {
+		return fmt.Errorf("tokens: %q is both a token and a group", strings.Join(path, "."))
+	}
+	if g.Tokens == nil {
+		g.Tokens = make(map[string]*Token)
+	}
+	g.Tokens[name] = t
+	return nil
+}
+
+// Lookup returns the token at a dot-separated path, such as "Colors.brand.primary".
+func (g *Group) Lookup(path string) (*Token, bool) {
+	names := strings.Split(path, ".")
+	for _, name := range names[:len(names)-1] {
+		if g = g.Groups[name]; g == nil {
+			return nil, false
+		}
+	}
+	t, ok := g.Tokens[names[len(names)-1]]
+	return t, ok
+}
+
+// MarshalJSON encodes the group as a JSON object with its tokens and groups in name order.
+func (g *Group) MarshalJSON() ([]byte, error) {
+	members := make(map[string]interface{}, len(g.Tokens)+len(g.Groups))
+	for name, t := range g.Tokens {
+		members[name] = t
+	}
+	for name, sub := range g.Groups {
+		members[name] = sub
+	}
+	names := make([]string, 0, len(members))
+	for name := range members {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var b bytes.Buffer
+	b.WriteByte('{')
+	for i, name := range names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		k, err := json.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		v, err := json.Marshal(members[name])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(k)
+		b.WriteByte(':')
+		b.Write(v)
+	}
+	b.WriteByte('}')
+	return b.Bytes(), nil
+}
+
+// Reference returns a reference to the token at path, such as "{Colors.brand.primary}".
+func Reference(path []string) string {
+	return "{" + strings.Join(path, ".") + "}"
+}
+
+// Color is a color value in the sRGB color space.
+type Color struct {
+	ColorSpace string     `json:"colorSpace"`
+	Components [3]float64 `json:"components"`
+	Alpha      float64    `json:"alpha"`
+	// The color as #rrggbb, without alpha.
+	Hex string `json:"hex"`
+}
+
+// NewColor returns the token value of c with its alpha multiplied by opacity.
+func NewColor(c figmatypes.Color, opacity float64) Color {
+	return Color{
+		ColorSpace: "srgb",
+		Components: [3]float64{round(c.R), round(c.G), round(c.B)},
+		Alpha:      round(c.A * opacity),
+		Hex:        fmt.Sprintf("#%02x%02x%02x", figmatypes.Channel8(c.R), figmatypes.Channel8(c.G), figmatypes.Channel8(c.B)),
+	}
+}
+
+// round rounds v to 4 decimal places, so that values computed from the file do not
+// carry floating point noise into the output.
+func round(v float64) float64 {
+	return math.Round(v*1e4) / 1e4
+}
+
+// Dimension is a length.
+type Dimension struct {
+	Value float64 `json:"value"`
+	Unit  string  `json:"unit"`
+}
+
+// Px returns a dimension of v pixels.
+func Px(v float64) Dimension {
+	return Dimension{Value: round(v), Unit: "px"}
+}
+
+// Typography is the value of a text style.
+type Typography struct {
+	FontFamily    string    `json:"fontFamily"`
+	FontSize      Dimension `json:"fontSize"`
+	FontWeight    float64   `json:"fontWeight"`
+	LetterSpacing Dimension `json:"letterSpacing"`
+	// The line height as a multiple of the font size.
+	LineHeight float64 `json:"lineHeight"`
+}
+
+// Shadow is a drop or inner shadow.
+type Shadow struct {
+	// A Color, or a reference to a color token.
+	Color   interface{} `json:"color"`
+	OffsetX Dimension   `json:"offsetX"`
+	OffsetY Dimension   `json:"offsetY"`
+	Blur    Dimension   `json:"blur"`
+	Spread  Dimension   `json:"spread"`
+	Inset   bool        `json:"inset,omitempty"`
+}
+
+// GradientStop is a color at a position, from 0 to 1, along a gradient.
+type GradientStop struct {
+	// A Color, or a reference to a color token.
+	Color    interface{} `json:"color"`
+	Position float64     `json:"position"`
+}
@@ -0,0 +1,194 @@
+package tokens
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatest"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// load builds a file using one style of each type and its local variables:
+// a collection of primitives and a theme collection with light and dark modes
+// aliasing them.
+func load(t *testing.T) (*figma.File, *figma.LocalVariables) {
+	t.Helper()
+	b := figmatest.New("Tokens")
+	prim := b.Collection("Primitives")
+	blue := prim.Variable("blue/500", figmatypes.VariableResolvedTypeCOLOR, figmatypes.Color{G: 0.4, B: 1, A: 1})
+	black := prim.Variable("black", figmatypes.VariableResolvedTypeCOLOR, figmatypes.Color{A: 1})
+	prim.Variable("space/1.5", figmatypes.VariableResolvedTypeFLOAT, 6).Scopes("GAP", "WIDTH_HEIGHT")
+	prim.Variable("weight/bold", figmatypes.VariableResolvedTypeFLOAT, 700).Scopes("FONT_WEIGHT")
+	b.Collection("Theme", "Light", "Dark").Variable("accent", figmatypes.VariableResolvedTypeCOLOR, blue.Alias(), black.Alias())
+
+	primary := b.Style("Brand/Primary", figmatypes.StyleTypeFILL)
+	b.Describe(primary, "Main brand color")
+	faded := b.Style("Brand/Faded", figmatypes.StyleTypeFILL)
+	h1 := b.Style("Heading / H1", figmatypes.StyleTypeTEXT)
+	elevation := b.Style("Elevation/1", figmatypes.StyleTypeEFFECT)
+	desktop := b.Style("Layout/Desktop", figmatypes.StyleTypeGRID)
+	sunset := b.Style("Brand/Sunset", figmatypes.StyleTypeFILL)
+	b.Style("Unused", figmatypes.StyleTypeFILL)
+
+	half := 0.5
+	frame := b.Canvas("Page").Frame("Frame", 0, 0, 1440, 900).
+		Style(primary).Style(elevation).Style(desktop).
+		Paint(figmatypes.Paint{
+			Type:           figmatypes.PaintTypeSOLID,
+			Color:          &figmatypes.Color{G: 0.4, B: 1, A: 1},
+			BoundVariables: map[string]figmatypes.VariableAlias{"color": blue.Alias()},
+		}).
+		Effect(figmatypes.Effect{
+			Type:   figmatypes.EffectTypeDROP_SHADOW,
+			Color:  figmatypes.Color{A: 0.25},
+			Offset: figmatypes.Vector{Y: 4},
+			Radius: 8,
+			Spread: 1,
+		}).
+		LayoutGrid(figmatypes.LayoutGrid{Pattern: figmatypes.LayoutGridPatternCOLUMNS, Count: 12, GutterSize: 24, Offset: 80})
+	rect := frame.Rectangle("Faded", 0, 0, 100, 100).
+		Paint(figmatypes.Paint{Type: figmatypes.PaintTypeSOLID, Opacity: &half, Color: &figmatypes.Color{R: 1, A: 1}})
+	// Some files key fill styles by "fills".
+	rect.Node().(*nodes.Rectangle).Styles = map[string]string{"fills": faded}
+	frame.Text("Title", "Tokens", 0, 100, 400, 40).Style(h1).
+		TextStyle(figmatypes.TypeStyle{FontFamily: "Inter", FontWeight: 700, FontSize: 32, LetterSpacing: -0.5, LineHeightPx: 40})
+	frame.Rectangle("Sunset", 0, 200, 100, 100).Style(sunset).
+		Paint(figmatypes.Paint{Type: figmatypes.PaintTypeGRADIENT_LINEAR, GradientStops: []figmatypes.ColorStop{
+			{Position: 0, Color: figmatypes.Color{R: 1, G: 0.5, A: 1}},
+			{Position: 1, Color: figmatypes.Color{R: 0.5, B: 0.5, A: 1}},
+		}})
+	return b.MustFile(t), b.Variables()
+}
+
+func value(t *testing.T, g *Group, path string) string {
+	t.Helper()
+	tok, ok := g.Lookup(path)
+	if !ok {
+		t.Fatalf("no token %s", path)
+	}
+	b, err := json.Marshal(tok.Value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return tok.Type + " " + string(b)
+}
+
+func TestExport(t *testing.T) {
+	f, lv := load(t)
+	g, err := Export(f, lv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := map[string]string{
+		"Brand.Primary":                     `color "{Primitives.blue.500}"`,
+		"Brand.Faded":                       `color {"colorSpace":"srgb","components":[1,0,0],"alpha":0.5,"hex":"#ff0000"}`,
+		"Brand.Sunset":                      `gradient [{"color":{"colorSpace":"srgb","components":[1,0.5,0],"alpha":1,"hex":"#ff8000"},"position":0},{"color":{"colorSpace":"srgb","components":[0.5,0,0.5],"alpha":1,"hex":"#800080"},"position":1}]`,
+		"Heading.H1":                        `typography {"fontFamily":"Inter","fontSize":{"value":32,"unit":"px"},"fontWeight":700,"letterSpacing":{"value":-0.5,"unit":"px"},"lineHeight":1.25}`,
+		"Elevation.1":                       `shadow {"color":{"colorSpace":"srgb","components":[0,0,0],"alpha":0.25,"hex":"#000000"},"offsetX":{"value":0,"unit":"px"},"offsetY":{"value":4,"unit":"px"},"blur":{"value":8,"unit":"px"},"spread":{"value":1,"unit":"px"}}`,
+		"Layout.Desktop.columns.count":      `number 12`,
+		"Layout.Desktop.columns.gutterSize": `dimension {"value":24,"unit":"px"}`,
+		"Primitives.space.1_5":              `dimension {"value":6,"unit":"px"}`,
+		"Primitives.weight.bold":            `fontWeight 700`,
+		"Theme.accent":                      `color "{Primitives.blue.500}"`,
+	}
+	for path, want := range tests {
+		if got := value(t, g, path); got != want {
+			t.Errorf("%s = %s\nwant %s", path, got, want)
+		}
+	}
+	if _, ok := g.Lookup("Unused"); ok {
+		t.Error("unused style was exported")
+	}
+	tok, _ := g.Lookup("Theme.accent")
+	modes, _ := json.Marshal(tok.Extensions)
+	if want := `{"com.figma":{"modes":{"Dark":"{Primitives.black}","Light":"{Primitives.blue.500}"},"variableId":"V:5"}}`; string(modes) != want {
+		t.Errorf("Theme.accent extensions = %s, want %s", modes, want)
+	}
+	if tok, _ := g.Lookup("Brand.Primary"); tok.Description != "Main brand color" {
+		t.Errorf("Brand.Primary description = %q", tok.Description)
+	}
+
+	dark, err := Export(f, lv, Mode("Theme", "Dark"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := value(t, dark, "Theme.accent"), `color "{Primitives.black}"`; got != want {
+		t.Errorf("dark Theme.accent = %s, want %s", got, want)
+	}
+	if _, err := Export(f, lv, Mode("Theme", "Dim")); err == nil {
+		t.Error("Export with unknown mode succeeded")
+	}
+
+	// A text style whose value could not be read is left out.
+	styles := []figma.StyleValue{{Style: figmatypes.Style{Name: "Body", StyleType: figmatypes.StyleTypeTEXT}, ID: "S:9"}}
+	if g, err := Export(f, nil, Styles(styles)); err != nil {
+		t.Error(err)
+	} else if _, ok := g.Lookup("Body"); ok {
+		t.Error("text style without a value was exported")
+	}
+
+	// Without variables, bound colors are exported as values.
+	plain, err := Export(f, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := value(t, plain, "Brand.Primary"); !strings.Contains(got, `"hex":"#0066ff"`) {
+		t.Errorf("Brand.Primary without variables = %s", got)
+	}
+}
+
+func TestExportDeterministic(t *testing.T) {
+	f, lv := load(t)
+	var first []byte
+	for i := 0; i < 10; i++ {
+		g, err := Export(f, lv)
+		if err != nil {
+			t.Fatal(err)
+		}
+		b, err := json.MarshalIndent(g, "", "  ")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if first == nil {
+			first = b
+		} else if !bytes.Equal(b, first) {
+			t.Fatalf("export %d differs:\n%s\nfirst:\n%s", i, b, first)
+		}
+	}
+	if !bytes.HasPrefix(first, []byte("{\n  \"Brand\": {\n    \"Faded\": {\n      \"$type\": \"color\",\n      \"$value\"")) {
+		t.Errorf("unexpected output:\n%s", first)
+	}
+}
+
+func TestGroupAddConflict(t *testing.T) {
+	g := new(Group)
+	if err := g.Add([]string{"a", "b"}, &Token{Value: 1.0}); err != nil {
+		t.Fatal(err)
+	}
+	for _, path := range [][]string{{"a", "b"}, {"a"}, {"a", "b", "c"}} {
+		if err := g.Add(path, &Token{Value: 2.0}); err == nil {
+			t.Errorf("Add(%v) succeeded", path)
+		}
+	}
+}
+
+func TestPath(t *testing.T) {
+	tests := []struct {
+		name string
+		want []string
+	}{
+		{"Brand / Primary", []string{"Brand", "Primary"}},
+		{"space/1.5", []string{"space", "1_5"}},
+		{"$root/{x}", []string{"_root", "_x_"}},
+		{"a//b/", []string{"a", "b"}},
+	}
+	for _, tt := range tests {
+		if got := Path(tt.name); strings.Join(got, "|") != strings.Join(tt.want, "|") {
+			t.Errorf("Path(%q) = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
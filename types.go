@@ -1,7 +1,11 @@
 package figma
 
 import (
+	"encoding/json"
+	"reflect"
+
 	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/internal/rawjson"
 	"github.com/tmc/figma/nodes"
 )
 
@@ -17,6 +21,13 @@ type ComponentReference struct {
 	Name        string `json:"name"`
 }
 
+// LocalVariables are the variables and variable collections defined in a file, keyed by ID.
+// Remote variables used in the file are included.
+type LocalVariables struct {
+	Variables           map[string]figmatypes.Variable           `json:"variables"`
+	VariableCollections map[string]figmatypes.VariableCollection `json:"variableCollections"`
+}
+
 // FileMeta stores metadata about a file.
 type FileMeta struct {
 	Key          string `json:"key,omitempty"`
@@ -62,13 +73,38 @@ type Image struct {
 
 // File is a Figma file.
 type File struct {
-	Name          string                                    `json:"name,omitempty"`
-	LastModified  string                                    `json:"lastModified,omitempty"`
-	ThumbnailURL  string                                    `json:"thumbnailUrl,omitempty"`
-	Document      nodes.Document                            `json:"document,omitempty"`
-	SchemaVersion int                                       `json:"schemaVersion"`
-	Styles        map[figmatypes.StyleType]figmatypes.Style `json:"styles"`
-	Components    map[string]ComponentReference             `json:"components,omitempty"`
+	Name          string                        `json:"name,omitempty"`
+	LastModified  string                        `json:"lastModified,omitempty"`
+	ThumbnailURL  string                        `json:"thumbnailUrl,omitempty"`
+	Document      nodes.Document                `json:"document,omitempty"`
+	SchemaVersion int                           `json:"schemaVersion"`
+	Styles        map[string]figmatypes.Style   `json:"styles"`
+	Components    map[string]ComponentReference `json:"components,omitempty"`
+
+	// the JSON object the file was decoded from, if any.
+	raw rawjson.Object
+}
+
+// UnmarshalJSON decodes a file, retaining the fields its Go type does not model.
+func (f *File) UnmarshalJSON(data []byte) error {
+	type file File
+	if err := json.Unmarshal(data, (*file)(f)); err != nil {
+		return err
+	}
+	raw, err := rawjson.Capture(data, reflect.TypeOf(f))
+	f.raw = raw
+	return err
+}
+
+// MarshalJSON encodes a file along with any fields retained when it was decoded,
+// so that a decoded file is written back out without loss.
+func (f File) MarshalJSON() ([]byte, error) {
+	type file File
+	b, err := json.Marshal(file(f))
+	if err != nil {
+		return nil, err
+	}
+	return rawjson.Merge(f.raw, b, reflect.TypeOf(f))
 }
 
 // Comment is a comment or reply left by a user.
@@ -0,0 +1,16 @@
+// Package variables resolves the variables bound to node fields to concrete
+// values, for a chosen mode of each variable collection.
+//
+// The mode used for a collection at a node is the nearest explicit mode set on
+// the node or its ancestors, falling back to the mode chosen with SetMode and
+// then to the collection's default mode. Aliases are followed through other
+// variables, each resolved in the mode of its own collection.
+//
+//	vars, err := client.GetLocalVariables(fileKey)
+//	...
+//	r := variables.New(vars)
+//	if err := r.SetMode("Theme", "Dark"); err != nil {
+//		return err
+//	}
+//	fills, err := r.List(path, node, "fills")
+package variables
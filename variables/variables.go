@@ -0,0 +1,174 @@
+package variables
+
+import (
+	"fmt"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/figmatypes"
+	"github.com/tmc/figma/nodes"
+)
+
+// Resolver resolves variable bindings against a set of variables.
+type Resolver struct {
+	variables   map[string]figmatypes.Variable
+	collections map[string]figmatypes.VariableCollection
+	// Modes chosen with SetMode, keyed by collection ID.
+	modes map[string]string
+}
+
+// New returns a resolver for the variables of a file.
+func New(v *figma.LocalVariables) *Resolver {
+	return &Resolver{
+		variables:   v.Variables,
+		collections: v.VariableCollections,
+		modes:       make(map[string]string),
+	}
+}
+
+// SetMode chooses the mode used for a collection where no ancestor sets one explicitly.
+// The collection and mode may be given by ID or by name.
+func (r *Resolver) SetMode(collection, mode string) error {
+	c, ok := r.collection(collection)
+	if !ok {
+		return fmt.Errorf("variables: unknown collection %q", collection)
+	}
+	m, ok := c.Mode(mode)
+	if !ok {
+		return fmt.Errorf("variables: collection %q has no mode %q", c.Name, mode)
+	}
+	r.modes[c.ID] = m.ModeID
+	return nil
+}
+
+func (r *Resolver) collection(idOrName string) (figmatypes.VariableCollection, bool) {
+	if c, ok := r.collections[idOrName]; ok {
+		return c, true
+	}
+	for _, c := range r.collections {
+		if c.Name == idOrName {
+			return c, true
+		}
+	}
+	return figmatypes.VariableCollection{}, false
+}
+
+// Mode returns the ID of the mode used for a collection at n, whose ancestors are path.
+func (r *Resolver) Mode(path nodes.Path, n nodes.Node, collectionID string) string {
+	if m, ok := explicitMode(n, collectionID); ok {
+		return m
+	}
+	for i := len(path) - 1; i >= 0; i-- {
+		if m, ok := explicitMode(path[i], collectionID); ok {
+			return m
+		}
+	}
+	if m, ok := r.modes[collectionID]; ok {
+		return m
+	}
+	return r.collections[collectionID].DefaultModeID
+}
+
+func explicitMode(n nodes.Node, collectionID string) (string, bool) {
+	b, ok := n.(nodes.VariableBound)
+	if !ok {
+		return "", false
+	}
+	m, ok := b.GetExplicitVariableModes()[collectionID]
+	return m, ok
+}
+
+// Resolve returns the value of a variable at n, following aliases.
+// The result is never an alias.
+func (r *Resolver) Resolve(path nodes.Path, n nodes.Node, variableID string) (figmatypes.VariableValue, error) {
+	seen := make(map[string]bool)
+	for {
+		if seen[variableID] {
+			return figmatypes.VariableValue{}, fmt.Errorf("variables: alias cycle through %s", variableID)
+		}
+		seen[variableID] = true
+		v, ok := r.variables[variableID]
+		if !ok {
+			return figmatypes.VariableValue{}, fmt.Errorf("variables: unknown variable %s", variableID)
+		}
+		mode := r.Mode(path, n, v.VariableCollectionID)
+		value, ok := v.ValuesByMode[mode]
+		if !ok {
+			return figmatypes.VariableValue{}, fmt.Errorf("variables: variable %q has no value for mode %s", v.Name, mode)
+		}
+		if value.Alias == nil {
+			return value, nil
+		}
+		variableID = value.Alias.ID
+	}
+}
+
+func bindings(n nodes.Node) map[string]figmatypes.VariableBinding {
+	if b, ok := n.(nodes.VariableBound); ok {
+		return b.GetBoundVariables()
+	}
+	return nil
+}
+
+// Field resolves the variable bound to a field of n, such as "paddingLeft", "topLeftRadius",
+// "itemSpacing" or "characters". It reports false if no single variable is bound to the field.
+func (r *Resolver) Field(path nodes.Path, n nodes.Node, field string) (figmatypes.VariableValue, bool, error) {
+	b, ok := bindings(n)[field]
+	if !ok || b.Alias == nil {
+		return figmatypes.VariableValue{}, false, nil
+	}
+	v, err := r.Resolve(path, n, b.Alias.ID)
+	return v, err == nil, err
+}
+
+// List resolves the variables bound to the elements of a list field of n, such as "fills" or "strokes".
+// The result has one value per binding, by index; it is nil if no variables are bound to the field.
+func (r *Resolver) List(path nodes.Path, n nodes.Node, field string) ([]figmatypes.VariableValue, error) {
+	b := bindings(n)[field]
+	if len(b.List) == 0 {
+		return nil, nil
+	}
+	result := make([]figmatypes.VariableValue, len(b.List))
+	for i, a := range b.List {
+		v, err := r.Resolve(path, n, a.ID)
+		if err != nil {
+			return nil, err
+		}
+		result[i] = v
+	}
+	return result, nil
+}
+
+// All resolves every variable bound to n. List elements are keyed as "fills[0]" and nested
+// bindings as "componentProperties.Label".
+func (r *Resolver) All(path nodes.Path, n nodes.Node) (map[string]figmatypes.VariableValue, error) {
+	result := make(map[string]figmatypes.VariableValue)
+	var add func(prefix string, b figmatypes.VariableBinding) error
+	add = func(prefix string, b figmatypes.VariableBinding) error {
+		if b.Alias != nil {
+			v, err := r.Resolve(path, n, b.Alias.ID)
+			if err != nil {
+				return err
+			}
+			result[prefix] = v
+		}
+		for i, a := range b.List {
+			v, err := r.Resolve(path, n, a.ID)
+			if err != nil {
+				return err
+			}
+			result[fmt.Sprintf("%s[%d]", prefix, i)] = v
+		}
+		for k, nested := range b.Fields {
+			if err := add(prefix+"."+k, nested); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	for k, b := range bindings(n) {
+		if err := add(k, b); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
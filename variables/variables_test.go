@@ -0,0 +1,126 @@
+package variables
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/tmc/figma"
+	"github.com/tmc/figma/nodes"
+)
+
+const localVariables = `{
+	"variableCollections": {
+		"C:prim": {"id": "C:prim", "name": "Primitives", "defaultModeId": "1:0", "modes": [{"modeId": "1:0", "name": "Value"}]},
+		"C:theme": {"id": "C:theme", "name": "Theme", "defaultModeId": "2:0",
+			"modes": [{"modeId": "2:0", "name": "Light"}, {"modeId": "2:1", "name": "Dark"}]}
+	},
+	"variables": {
+		"V:white": {"id": "V:white", "name": "white", "variableCollectionId": "C:prim", "resolvedType": "COLOR",
+			"valuesByMode": {"1:0": {"r": 1, "g": 1, "b": 1, "a": 1}}},
+		"V:gray": {"id": "V:gray", "name": "gray/900", "variableCollectionId": "C:prim", "resolvedType": "COLOR",
+			"valuesByMode": {"1:0": {"r": 0.1, "g": 0.1, "b": 0.1, "a": 1}}},
+		"V:space": {"id": "V:space", "name": "space/4", "variableCollectionId": "C:prim", "resolvedType": "FLOAT",
+			"valuesByMode": {"1:0": 16}},
+		"V:bg": {"id": "V:bg", "name": "bg", "variableCollectionId": "C:theme", "resolvedType": "COLOR",
+			"valuesByMode": {"2:0": {"type": "VARIABLE_ALIAS", "id": "V:white"}, "2:1": {"type": "VARIABLE_ALIAS", "id": "V:gray"}}},
+		"V:surface": {"id": "V:surface", "name": "surface", "variableCollectionId": "C:theme", "resolvedType": "COLOR",
+			"valuesByMode": {"2:0": {"type": "VARIABLE_ALIAS", "id": "V:bg"}, "2:1": {"type": "VARIABLE_ALIAS", "id": "V:bg"}}},
+		"V:greeting": {"id": "V:greeting", "name": "greeting", "variableCollectionId": "C:theme", "resolvedType": "STRING",
+			"valuesByMode": {"2:0": "Good morning", "2:1": "Good night"}},
+		"V:loop": {"id": "V:loop", "name": "loop", "variableCollectionId": "C:prim", "resolvedType": "FLOAT",
+			"valuesByMode": {"1:0": {"type": "VARIABLE_ALIAS", "id": "V:loop"}}}
+	}
+}`
+
+const page = `{"id": "0:1", "type": "CANVAS", "children": [
+	{"id": "1:1", "type": "FRAME", "explicitVariableModes": {"C:theme": "2:1"}, "children": [
+		{"id": "1:2", "type": "RECTANGLE", "boundVariables": {
+			"fills": [{"type": "VARIABLE_ALIAS", "id": "V:surface"}],
+			"topLeftRadius": {"type": "VARIABLE_ALIAS", "id": "V:space"}
+		}}
+	]},
+	{"id": "1:3", "type": "FRAME", "boundVariables": {"paddingLeft": {"type": "VARIABLE_ALIAS", "id": "V:space"}}, "children": [
+		{"id": "1:4", "type": "TEXT", "boundVariables": {
+			"characters": {"type": "VARIABLE_ALIAS", "id": "V:greeting"},
+			"componentProperties": {"Label": {"type": "VARIABLE_ALIAS", "id": "V:greeting"}}
+		}},
+		{"id": "1:5", "type": "RECTANGLE", "boundVariables": {"opacity": {"type": "VARIABLE_ALIAS", "id": "V:loop"}}}
+	]}
+]}`
+
+func setup(t *testing.T) (*Resolver, map[string]nodes.Path, map[string]nodes.Node) {
+	t.Helper()
+	var lv figma.LocalVariables
+	if err := json.Unmarshal([]byte(localVariables), &lv); err != nil {
+		t.Fatal(err)
+	}
+	var c nodes.Children
+	if err := json.Unmarshal([]byte("["+page+"]"), &c); err != nil {
+		t.Fatal(err)
+	}
+	paths := make(map[string]nodes.Path)
+	byID := make(map[string]nodes.Node)
+	for path, n := range nodes.All(c[0]) {
+		paths[n.GetID()] = path.Clone()
+		byID[n.GetID()] = n
+	}
+	return New(&lv), paths, byID
+}
+
+func TestResolver(t *testing.T) {
+	r, paths, byID := setup(t)
+
+	// The frame sets the Theme collection to Dark, and surface aliases bg, which aliases gray.
+	fills, err := r.List(paths["1:2"], byID["1:2"], "fills")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(fills) != 1 || fills[0].Color == nil || fills[0].Color.R != 0.1 {
+		t.Errorf("dark fills = %+v", fills)
+	}
+	if v, ok, err := r.Field(paths["1:2"], byID["1:2"], "topLeftRadius"); !ok || err != nil || *v.Float != 16 {
+		t.Errorf("topLeftRadius = %+v, %v, %v", v, ok, err)
+	}
+	if v, ok, _ := r.Field(paths["1:3"], byID["1:3"], "paddingLeft"); !ok || *v.Float != 16 {
+		t.Errorf("paddingLeft = %+v", v)
+	}
+
+	// Without an explicit mode, the default applies until another is chosen.
+	path, text := paths["1:4"], byID["1:4"]
+	if v, _, _ := r.Field(path, text, "characters"); v.String == nil || *v.String != "Good morning" {
+		t.Errorf("default characters = %+v", v)
+	}
+	if err := r.SetMode("Theme", "Dark"); err != nil {
+		t.Fatal(err)
+	}
+	if v, _, _ := r.Field(path, text, "characters"); v.String == nil || *v.String != "Good night" {
+		t.Errorf("dark characters = %+v", v)
+	}
+	all, err := r.All(path, text)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := all["componentProperties.Label"]; v.String == nil || *v.String != "Good night" {
+		t.Errorf("All() = %+v", all)
+	}
+
+	if err := r.SetMode("Theme", "Sepia"); err == nil {
+		t.Error("SetMode accepted unknown mode")
+	}
+	if _, _, err := r.Field(paths["1:5"], byID["1:5"], "opacity"); err == nil || !strings.Contains(err.Error(), "cycle") {
+		t.Errorf("alias cycle: got %v", err)
+	}
+	if _, ok, _ := r.Field(path, text, "fills"); ok {
+		t.Error("Field reported an unbound field")
+	}
+
+	// Bindings survive encoding.
+	b, err := nodes.Marshal(byID["1:2"])
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"fills":[{"type":"VARIABLE_ALIAS","id":"V:surface"}]`) {
+		t.Errorf("bindings not encoded: %s", b)
+	}
+}
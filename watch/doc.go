@@ -0,0 +1,4 @@
+// Package watch polls the Figma API for changes to files and reports them as events.
+//
+// It is an alternative to webhooks for teams that cannot register them, and produces the same figma.Event values.
+package watch
@@ -0,0 +1,116 @@
+package watch
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// State is the cursor of a Watcher. It records what has already been reported.
+type State struct {
+	// Files that have been observed, keyed by file key.
+	Files map[string]*FileState `json:"files"`
+}
+
+// clone returns a deep copy of s.
+func (s *State) clone() *State {
+	c := &State{Files: make(map[string]*FileState, len(s.Files))}
+	for key, f := range s.Files {
+		fc := *f
+		fc.Comments = make(map[string]bool, len(f.Comments))
+		for id, resolved := range f.Comments {
+			fc.Comments[id] = resolved
+		}
+		c.Files[key] = &fc
+	}
+	return c
+}
+
+// FileState is the observed state of a single file.
+type FileState struct {
+	// Name of the file.
+	Name string `json:"name"`
+	// LastModified time of the file when last polled.
+	LastModified string `json:"last_modified"`
+	// ID of the newest version seen.
+	LatestVersionID string `json:"latest_version_id,omitempty"`
+	// Comments seen, mapping comment id to whether it was resolved.
+	Comments map[string]bool `json:"comments,omitempty"`
+}
+
+// Store persists the state of a Watcher so restarts do not replay old events.
+type Store interface {
+	// Load returns the saved state, or nil if there is none.
+	Load() (*State, error)
+	// Save stores the state.
+	Save(*State) error
+}
+
+// FileStore stores state as JSON in the named file.
+type FileStore string
+
+// Load implements Store.
+func (f FileStore) Load() (*State, error) {
+	buf, err := ioutil.ReadFile(string(f))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	s := &State{}
+	return s, json.Unmarshal(buf, s)
+}
+
+// Save implements Store. The file is replaced atomically.
+func (f FileStore) Save(s *State) error {
+	buf, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(string(f)), filepath.Base(string(f))+".*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(buf); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), string(f))
+}
+
+// MemoryStore keeps state in memory.
+type MemoryStore struct {
+	mu    sync.Mutex
+	state []byte
+}
+
+// Load implements Store.
+func (m *MemoryStore) Load() (*State, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.state == nil {
+		return nil, nil
+	}
+	s := &State{}
+	return s, json.Unmarshal(m.state, s)
+}
+
+// Save implements Store.
+func (m *MemoryStore) Save(s *State) error {
+	buf, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.state = buf
+	return nil
+}
@@ -0,0 +1,288 @@
+package watch
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/tmc/figma"
+)
+
+// DefaultInterval is the polling interval used when none is configured.
+const DefaultInterval = time.Minute
+
+// DefaultMaxFailures is the number of consecutive failed polls after which Run
+// gives up, when none is configured.
+const DefaultMaxFailures = 5
+
+// Watcher polls a set of projects and reports changes to their files.
+//
+// The first poll without saved state records a baseline and reports nothing.
+type Watcher struct {
+	// Interval between polls. Defaults to DefaultInterval.
+	Interval time.Duration
+	// Store persists the cursor between runs. Defaults to a MemoryStore.
+	Store Store
+	// MaxFailures is the number of consecutive failed polls after which Run
+	// returns the last error. Defaults to DefaultMaxFailures.
+	MaxFailures int
+
+	client     *figma.Client
+	projectIDs []string
+	state      *State
+	// Whether no cursor has been saved yet, so the next poll records a baseline.
+	baseline bool
+}
+
+// New initializes a new Watcher for the files in the given projects.
+func New(c *figma.Client, projectIDs ...string) *Watcher {
+	return &Watcher{
+		client:     c,
+		projectIDs: projectIDs,
+	}
+}
+
+// Run polls until ctx is done, sending events on the provided channel.
+//
+// The cursor is saved only once all the events of a poll have been sent, so an
+// interrupted run sends them again when restarted from the same Store: events
+// are delivered at least once.
+//
+// A failed poll is retried with exponential backoff, starting at a second and
+// capped at the interval. Run returns the error of the last poll after
+// MaxFailures consecutive failures, or at once if the API rejected a request
+// with a client error other than 429 Too Many Requests, which retrying would
+// not fix. Cancelling ctx returns ctx.Err().
+func (w *Watcher) Run(ctx context.Context, events chan<- figma.Event) error {
+	interval := w.Interval
+	if interval <= 0 {
+		interval = DefaultInterval
+	}
+	maxFailures := w.MaxFailures
+	if maxFailures <= 0 {
+		maxFailures = DefaultMaxFailures
+	}
+	failures := 0
+	for {
+		wait := interval
+		evs, next, err := w.poll()
+		if err == nil {
+			for _, e := range evs {
+				select {
+				case events <- e:
+				case <-ctx.Done():
+					return ctx.Err()
+				}
+			}
+			err = w.commit(next)
+		}
+		if err != nil {
+			if failures++; failures >= maxFailures || permanent(err) {
+				return err
+			}
+			wait = retryDelay(failures, interval)
+		} else {
+			failures = 0
+		}
+		t := time.NewTimer(wait)
+		select {
+		case <-t.C:
+		case <-ctx.Done():
+			t.Stop()
+			return ctx.Err()
+		}
+	}
+}
+
+// retryDelay returns how long to wait after the given number of consecutive failures.
+func retryDelay(failures int, interval time.Duration) time.Duration {
+	d := time.Second
+	for i := 1; i < failures && d < interval; i++ {
+		d *= 2
+	}
+	if d > interval {
+		d = interval
+	}
+	return d
+}
+
+// permanent reports whether err is a client error from the API that retrying will not fix.
+func permanent(err error) bool {
+	e, ok := errors.Cause(err).(*figma.Error)
+	return ok && e.StatusCode >= 400 && e.StatusCode < 500 && e.StatusCode != http.StatusTooManyRequests
+}
+
+// Poll checks every watched file once and returns the events observed since the previous poll.
+// The updated cursor is saved to the Store before returning, so the caller is responsible
+// for the events once Poll returns them.
+func (w *Watcher) Poll() ([]figma.Event, error) {
+	events, next, err := w.poll()
+	if err != nil {
+		return nil, err
+	}
+	return events, w.commit(next)
+}
+
+// poll checks every watched file once and returns the events observed since the
+// cursor was last saved, and the cursor that follows them. The watcher's cursor
+// is left unchanged until commit.
+func (w *Watcher) poll() ([]figma.Event, *State, error) {
+	if w.Store == nil {
+		w.Store = &MemoryStore{}
+	}
+	if w.state == nil {
+		s, err := w.Store.Load()
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "loading state")
+		}
+		if s == nil {
+			s = &State{}
+			w.baseline = true
+		}
+		if s.Files == nil {
+			s.Files = make(map[string]*FileState)
+		}
+		w.state = s
+	}
+
+	next := w.state.clone()
+	var events []figma.Event
+	for _, projectID := range w.projectIDs {
+		files, err := w.client.GetFilesForProject(projectID)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "getting files for project %v", projectID)
+		}
+		sort.Slice(files, func(i, j int) bool { return files[i].Key < files[j].Key })
+		for _, f := range files {
+			evs, err := w.pollFile(next, f, w.baseline)
+			if err != nil {
+				return nil, nil, errors.Wrapf(err, "polling file %v", f.Key)
+			}
+			events = append(events, evs...)
+		}
+	}
+	return events, next, nil
+}
+
+// commit saves next as the cursor.
+func (w *Watcher) commit(next *State) error {
+	if err := w.Store.Save(next); err != nil {
+		return errors.Wrap(err, "saving state")
+	}
+	w.state = next
+	w.baseline = false
+	return nil
+}
+
+func (w *Watcher) pollFile(state *State, f figma.FileMeta, baseline bool) ([]figma.Event, error) {
+	var events []figma.Event
+	st, seen := state.Files[f.Key]
+	if !seen {
+		st = &FileState{Comments: make(map[string]bool)}
+		state.Files[f.Key] = st
+	}
+	modified := !seen || st.LastModified != f.LastModified
+	st.Name = f.Name
+	// files appearing after the baseline are reported once as updated, without their history.
+	quiet := baseline || !seen
+	if modified {
+		if !baseline {
+			events = append(events, figma.Event{
+				Type:      figma.EventTypeFILE_UPDATE,
+				FileKey:   f.Key,
+				FileName:  f.Name,
+				Timestamp: f.LastModified,
+			})
+		}
+		st.LastModified = f.LastModified
+
+		versions, err := w.client.GetFileVersions(f.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "getting versions")
+		}
+		events = append(events, w.versionEvents(f, st, versions, quiet)...)
+	}
+
+	comments, err := w.client.GetFileComments(f.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "getting comments")
+	}
+	events = append(events, w.commentEvents(f, st, comments, quiet)...)
+	return events, nil
+}
+
+// versionEvents reports named versions newer than the latest version seen. Versions are listed
+// newest first. Unlabeled versions are autosaves, for which webhooks send no event.
+func (w *Watcher) versionEvents(f figma.FileMeta, st *FileState, versions []figma.Version, quiet bool) []figma.Event {
+	if len(versions) == 0 {
+		return nil
+	}
+	var events []figma.Event
+	for _, v := range versions {
+		if v.ID == st.LatestVersionID {
+			break
+		}
+		if v.Label == "" {
+			continue
+		}
+		events = append(events, figma.Event{
+			Type:        figma.EventTypeFILE_VERSION_UPDATE,
+			FileKey:     f.Key,
+			FileName:    f.Name,
+			Timestamp:   v.CreatedAt,
+			TriggeredBy: userOrNil(v.User),
+			VersionID:   v.ID,
+			Label:       v.Label,
+			Description: v.Description,
+		})
+	}
+	st.LatestVersionID = versions[0].ID
+	if quiet {
+		return nil
+	}
+	// report oldest first.
+	for i, j := 0, len(events)-1; i < j; i, j = i+1, j-1 {
+		events[i], events[j] = events[j], events[i]
+	}
+	return events
+}
+
+func (w *Watcher) commentEvents(f figma.FileMeta, st *FileState, comments []figma.Comment, quiet bool) []figma.Event {
+	sort.SliceStable(comments, func(i, j int) bool { return comments[i].CreatedAt < comments[j].CreatedAt })
+	var events []figma.Event
+	for _, c := range comments {
+		resolved := c.ResolvedAt != ""
+		wasResolved, seen := st.Comments[c.ID]
+		st.Comments[c.ID] = resolved
+		if quiet {
+			continue
+		}
+		e := figma.Event{
+			FileKey:     f.Key,
+			FileName:    f.Name,
+			Timestamp:   c.CreatedAt,
+			TriggeredBy: userOrNil(c.User),
+			CommentID:   c.ID,
+			Comment:     []figma.CommentFragment{{Text: c.Message}},
+		}
+		if !seen {
+			e.Type = figma.EventTypeFILE_COMMENT
+			events = append(events, e)
+		}
+		if resolved && !wasResolved {
+			e.Type = figma.EventTypeFILE_COMMENT_RESOLVED
+			e.Timestamp = c.ResolvedAt
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func userOrNil(u figma.User) *figma.User {
+	if u == (figma.User{}) {
+		return nil
+	}
+	return &u
+}
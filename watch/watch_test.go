@@ -0,0 +1,189 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/tmc/figma"
+)
+
+type fakeAPI struct {
+	mu           sync.Mutex
+	lastModified string
+	versions     []string
+	comments     []string
+	// The status of the next failures responses, if not zero.
+	failStatus int
+	failures   int
+}
+
+func (f *fakeAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if f.failures > 0 {
+		f.failures--
+		http.Error(w, "unavailable", f.failStatus)
+		return
+	}
+	switch r.URL.Path {
+	case "/projects/p1/files":
+		fmt.Fprintf(w, `{"files":[{"key":"k1","name":"File","last_modified":%q}]}`, f.lastModified)
+	case "/files/k1/versions":
+		fmt.Fprintf(w, `{"versions":[%s]}`, strings.Join(f.versions, ","))
+	case "/files/k1/comments":
+		fmt.Fprintf(w, `{"comments":[%s]}`, strings.Join(f.comments, ","))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func types(events []figma.Event) []figma.EventType {
+	var result []figma.EventType
+	for _, e := range events {
+		result = append(result, e.Type)
+	}
+	return result
+}
+
+func TestPoll(t *testing.T) {
+	api := &fakeAPI{
+		lastModified: "1",
+		versions:     []string{`{"id":"v1"}`},
+		comments:     []string{`{"id":"c1","message":"old"}`},
+	}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	c, _ := figma.NewClient("token", figma.WithBaseURL(srv.URL+"/"))
+	store := FileStore(filepath.Join(t.TempDir(), "state.json"))
+
+	poll := func() []figma.Event {
+		t.Helper()
+		w := New(c, "p1")
+		w.Store = store
+		events, err := w.Poll()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return events
+	}
+
+	if events := poll(); len(events) != 0 {
+		t.Fatalf("baseline poll reported %v", types(events))
+	}
+	if events := poll(); len(events) != 0 {
+		t.Fatalf("restarted watcher replayed %v", types(events))
+	}
+
+	api.lastModified = "2"
+	// v2a is an autosave, which webhooks do not report.
+	api.versions = []string{`{"id":"v3","label":"Release"}`, `{"id":"v2a"}`, `{"id":"v2","label":"Draft"}`, `{"id":"v1"}`}
+	api.comments = []string{
+		`{"id":"c1","message":"old","resolved_at":"3"}`,
+		`{"id":"c2","message":"new","created_at":"3"}`,
+	}
+	events := poll()
+	got := fmt.Sprint(types(events))
+	want := fmt.Sprint([]figma.EventType{
+		figma.EventTypeFILE_UPDATE,
+		figma.EventTypeFILE_VERSION_UPDATE,
+		figma.EventTypeFILE_VERSION_UPDATE,
+		figma.EventTypeFILE_COMMENT_RESOLVED,
+		figma.EventTypeFILE_COMMENT,
+	})
+	if got != want {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	if events[1].VersionID != "v2" || events[2].VersionID != "v3" {
+		t.Errorf("versions reported out of order: %v, %v", events[1].VersionID, events[2].VersionID)
+	}
+	if events := poll(); len(events) != 0 {
+		t.Fatalf("unchanged poll reported %v", types(events))
+	}
+}
+
+func TestRunRedeliversInterruptedPoll(t *testing.T) {
+	api := &fakeAPI{lastModified: "1", versions: []string{`{"id":"v1"}`}}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	c, _ := figma.NewClient("token", figma.WithBaseURL(srv.URL+"/"))
+	store := &MemoryStore{}
+
+	w := New(c, "p1")
+	w.Store = store
+	if _, err := w.Poll(); err != nil {
+		t.Fatal(err)
+	}
+	api.mu.Lock()
+	api.lastModified = "2"
+	api.versions = []string{`{"id":"v2","label":"Draft"}`, `{"id":"v1"}`}
+	api.mu.Unlock()
+
+	// Take the first event of the poll and stop before the second is sent.
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan figma.Event)
+	done := make(chan error)
+	go func() { done <- w.Run(ctx, events) }()
+	if e := <-events; e.Type != figma.EventTypeFILE_UPDATE {
+		t.Fatalf("got %v, want %v", e.Type, figma.EventTypeFILE_UPDATE)
+	}
+	cancel()
+	if err := <-done; err != context.Canceled {
+		t.Fatalf("Run returned %v, want %v", err, context.Canceled)
+	}
+
+	w = New(c, "p1")
+	w.Store = store
+	got, err := w.Poll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := fmt.Sprint([]figma.EventType{figma.EventTypeFILE_UPDATE, figma.EventTypeFILE_VERSION_UPDATE})
+	if fmt.Sprint(types(got)) != want {
+		t.Errorf("after an interrupted run got %v, want %v", types(got), want)
+	}
+}
+
+func TestRunRetries(t *testing.T) {
+	api := &fakeAPI{lastModified: "1", failStatus: http.StatusServiceUnavailable, failures: 2}
+	srv := httptest.NewServer(api)
+	defer srv.Close()
+	c, _ := figma.NewClient("token", figma.WithBaseURL(srv.URL+"/"))
+
+	w := New(c, "p1")
+	w.Interval = time.Millisecond
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := w.Run(ctx, make(chan figma.Event)); err != context.DeadlineExceeded {
+		t.Fatalf("Run returned %v, want it to recover from transient failures", err)
+	}
+
+	api.mu.Lock()
+	api.failures = 1 << 10
+	api.mu.Unlock()
+	w = New(c, "p1")
+	w.Interval = time.Millisecond
+	w.MaxFailures = 3
+	if err := w.Run(context.Background(), make(chan figma.Event)); err == nil {
+		t.Fatal("Run returned nil after persistent failures")
+	}
+
+	api.mu.Lock()
+	api.failStatus, api.failures = http.StatusForbidden, 1
+	api.mu.Unlock()
+	w = New(c, "p1")
+	w.Interval = time.Hour
+	start := time.Now()
+	if err := w.Run(context.Background(), make(chan figma.Event)); err == nil {
+		t.Fatal("Run returned nil after a client error")
+	}
+	if d := time.Since(start); d > time.Second {
+		t.Errorf("Run retried a client error for %v", d)
+	}
+}